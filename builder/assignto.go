@@ -46,7 +46,7 @@ func AssignByBuild(b Builder, gen Generator, ctx *MethodContext, assignTo *Assig
 }
 
 func BuildByAssign(b Builder, gen Generator, ctx *MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, path ErrorPath) ([]jen.Code, *xtype.JenID, *Error) {
-	buildStmt, assignTo, err := buildTargetVar(gen, ctx, sourceID, source, target, path)
+	buildStmt, assignTo, err := BuildTargetVar(gen, ctx, sourceID, source, target, path)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/jmattheis/goverter/config"
 	"github.com/jmattheis/goverter/method"
 	"github.com/jmattheis/goverter/namer"
+	"github.com/jmattheis/goverter/report"
 	"github.com/jmattheis/goverter/xtype"
 )
 
@@ -56,6 +57,13 @@ type Generator interface {
 	ReturnError(ctx *MethodContext,
 		path ErrorPath,
 		id *jen.Statement) (jen.Code, bool)
+
+	// ReturnGuardError is like ReturnError but always returns from the
+	// method, also with errors:collect. Use it when the following code
+	// depends on the guarded condition.
+	ReturnGuardError(ctx *MethodContext,
+		path ErrorPath,
+		id *jen.Statement) (jen.Code, bool)
 }
 
 // MethodContext exposes information for the current method.
@@ -76,6 +84,23 @@ type MethodContext struct {
 	AvailableContext map[string]*xtype.Type
 
 	TargetVar *jen.Statement
+
+	// ErrorsVar is the slice collecting the errors of the method, if
+	// errors:collect is enabled and an error can occur.
+	ErrorsVar *jen.Statement
+
+	// SourceParams contains all source params if the method has multiple source params.
+	SourceParams []*SourceParam
+
+	// Report records the field mappings of the method.
+	Report *report.Method
+}
+
+// SourceParam is a named source param of a conversion method.
+type SourceParam struct {
+	Name string
+	ID   *xtype.JenID
+	Type *xtype.Type
 }
 
 func (ctx *MethodContext) HasSeen(source *xtype.Type) bool {
@@ -101,6 +126,15 @@ func (ctx *MethodContext) SetErrorTargetVar(m *jen.Statement) {
 	}
 }
 
+func (ctx *MethodContext) isMultiSource(source, target *xtype.Type) bool {
+	if len(ctx.SourceParams) == 0 || ctx.FieldsTarget != target.String {
+		return false
+	}
+	methodSource := ctx.Conf.Source
+	return types.Identical(methodSource.T, source.T) ||
+		(methodSource.Pointer && types.Identical(methodSource.PointerInner.T, source.T))
+}
+
 func (ctx *MethodContext) Field(target *xtype.Type, name string) *config.FieldMapping {
 	if ctx.FieldsTarget != target.String {
 		return emptyMapping
@@ -113,18 +147,6 @@ func (ctx *MethodContext) Field(target *xtype.Type, name string) *config.FieldMa
 	return prop
 }
 
-func (ctx *MethodContext) DefinedFields(target *xtype.Type) map[string]struct{} {
-	if ctx.FieldsTarget != target.String {
-		return emptyFields
-	}
-
-	f := map[string]struct{}{}
-	for name := range ctx.Conf.Fields {
-		f[name] = struct{}{}
-	}
-	return f
-}
-
 func (ctx *MethodContext) DefinedEnumFields(target *xtype.Type) map[string]struct{} {
 	if ctx.FieldsTarget != target.String {
 		return emptyFields
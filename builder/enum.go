@@ -2,6 +2,7 @@ package builder
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dave/jennifer/jen"
 	"github.com/jmattheis/goverter/config"
@@ -25,7 +26,7 @@ func isEnum(ctx *MethodContext, source, target *xtype.Type) bool {
 
 // Build creates conversion source code for the given source and target type.
 func (*Enum) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, path ErrorPath) ([]jen.Code, *xtype.JenID, *Error) {
-	stmt, nameAssign, err := buildTargetVar(gen, ctx, sourceID, source, target, path)
+	stmt, nameAssign, err := BuildTargetVar(gen, ctx, sourceID, source, target, path)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -38,16 +39,18 @@ func (*Enum) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, sou
 
 	definedKeys := ctx.DefinedEnumFields(target)
 
-	transformerMapping, err := executeTransformers(ctx.Conf.EnumMapping.Transformers, source, target, sourceEnum, targetEnum)
+	transformerMapping, err := executeTransformers(ctx.Conf.EnumMapping.Transformers, sourceEnum.Enum, targetEnum.Enum)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	sourceTargetMapping := map[interface{}]enumMapping{}
+	var unmapped []string
 	for _, sourceName := range sourceEnum.SortedMembers() {
 		delete(definedKeys, sourceName)
 
-		targetName, ok := ctx.Conf.EnumMapping.Map[sourceName]
+		targetName, explicit := ctx.Conf.EnumMapping.Map[sourceName]
+		ok := explicit
 		if !ok {
 			targetName, ok = transformerMapping[sourceName]
 		}
@@ -55,8 +58,14 @@ func (*Enum) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, sou
 		if !ok {
 			targetName = sourceName
 		}
+		// explicit enum:map actions are a decision for this single member and
+		// don't break exhaustiveness. enum:transform actions may match members
+		// that are added later, so they are reported.
+		if config.IsEnumAction(targetName) && !explicit {
+			unmapped = append(unmapped, fmt.Sprintf("%s -> %s", sourceName, targetName))
+		}
 
-		sourceQual := jen.Qual(source.NamedType.Obj().Pkg().Path(), sourceName)
+		sourceQual := jen.Qual(sourceEnum.Package(sourceName), sourceName)
 		body, err := caseAction(gen, ctx, nameVar, target, targetEnum, targetName, sourceID, path)
 		if err != nil {
 			return nil, nil, err.Lift(&Path{
@@ -89,6 +98,18 @@ func (*Enum) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, sou
 		}
 	}
 
+	if ctx.Conf.Enum.Exhaustive {
+		var unreached []string
+		for _, targetName := range targetEnum.SortedMembers() {
+			if !enumValueReached(sourceTargetMapping, targetEnum, targetName) && !enumUnknownReached(ctx, targetEnum, targetName) {
+				unreached = append(unreached, targetName)
+			}
+		}
+		if err := exhaustiveError(unmapped, unreached); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	enumUnknown := ctx.Conf.Common.Enum.Unknown
 	if enumUnknown == "" {
 		return nil, nil, NewError("Enum detected but enum:unknown is not configured.\nSee https://goverter.jmattheis.de/guide/enum")
@@ -131,7 +152,7 @@ func caseAction(gen Generator, ctx *MethodContext, nameVar *jen.Statement, targe
 			return jen.Panic(jen.Qual("fmt", "Sprintf").Call(jen.Lit("unexpected enum element: %v"), sourceID.Code.Clone())), nil
 		case config.EnumActionError:
 			errStmt := jen.Qual("fmt", "Errorf").Call(jen.Lit("unexpected enum element: %v"), sourceID.Code.Clone())
-			code, ok := gen.ReturnError(ctx, errPath, errStmt)
+			code, ok := gen.ReturnGuardError(ctx, errPath, errStmt)
 			if !ok {
 				return nil, NewError(fmt.Sprintf("Cannot return %s because the explicitly defined conversion method doesn't return an error.", config.EnumActionError))
 			}
@@ -145,16 +166,16 @@ func caseAction(gen Generator, ctx *MethodContext, nameVar *jen.Statement, targe
 		return nil, NewError(fmt.Sprintf("Enum %s does not exist on\n    %s\n\nSee https://goverter.jmattheis.de/guide/enum", targetName, target.String))
 	}
 
-	targetQual := jen.Qual(target.NamedType.Obj().Pkg().Path(), targetName)
+	targetQual := jen.Qual(targetEnum.Package(targetName), targetName)
 	return nameVar.Clone().Op("=").Add(targetQual), nil
 }
 
-func executeTransformers(transformers []config.ConfiguredTransformer, source, target *xtype.Type, sourceEnum, targetEnum *xtype.Enum) (map[string]string, *Error) {
+func executeTransformers(transformers []config.ConfiguredTransformer, source, target enum.Enum) (map[string]string, *Error) {
 	transformerMapping := map[string]string{}
 	for _, t := range transformers {
 		m, err := t.Transformer(enum.TransformContext{
-			Source: enum.Enum{Type: source.NamedType, Members: sourceEnum.Members},
-			Target: enum.Enum{Type: target.NamedType, Members: targetEnum.Members},
+			Source: source,
+			Target: target,
 			Config: t.Config,
 		})
 		if err != nil {
@@ -193,6 +214,41 @@ See https://goverter.jmattheis.de/guide/enum#mapping-enum-keys`,
 		sourceName, previous.Target))
 }
 
+// enumValueReached returns true, if a source member is mapped to a target
+// member with the value of targetName.
+func enumValueReached(mapping map[interface{}]enumMapping, targetEnum *xtype.Enum, targetName string) bool {
+	for _, m := range mapping {
+		if !config.IsEnumAction(m.Target) && targetEnum.Members[m.Target] == targetEnum.Members[targetName] {
+			return true
+		}
+	}
+	return false
+}
+
+// enumUnknownReached returns true, if enum:unknown is set to a target member
+// with the same value as targetName. It is reached by unknown source values.
+func enumUnknownReached(ctx *MethodContext, targetEnum *xtype.Enum, targetName string) bool {
+	value, ok := targetEnum.Members[ctx.Conf.Common.Enum.Unknown]
+	return ok && value == targetEnum.Members[targetName]
+}
+
+// exhaustiveError returns an error, if there are source members without a
+// target member or target members that cannot be reached.
+func exhaustiveError(unmapped, unreached []string) *Error {
+	if len(unmapped) == 0 && len(unreached) == 0 {
+		return nil
+	}
+
+	msg := "Enum conversion is not exhaustive, but enum:exhaustive is enabled."
+	if len(unmapped) > 0 {
+		msg += "\n\nSource members without a target member:\n    " + strings.Join(unmapped, "\n    ")
+	}
+	if len(unreached) > 0 {
+		msg += "\n\nTarget members without a source member:\n    " + strings.Join(unreached, "\n    ")
+	}
+	return NewError(msg + "\n\nSee https://goverter.jmattheis.de/reference/enum#enum-exhaustive-yes-no")
+}
+
 func fmtEnumValue(targetEnum *xtype.Enum, targetName string) string {
 	if config.IsEnumAction(targetName) {
 		return fmt.Sprintf("%s(action)", targetName)
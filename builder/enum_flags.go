@@ -0,0 +1,206 @@
+package builder
+
+import (
+	"fmt"
+	"go/types"
+	"math/big"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/jmattheis/goverter/config"
+	"github.com/jmattheis/goverter/enum"
+	"github.com/jmattheis/goverter/xtype"
+)
+
+// EnumFlags handles bit flag enums.
+type EnumFlags struct{}
+
+// Matches returns true, if the builder can create handle the given types.
+func (*EnumFlags) Matches(ctx *MethodContext, source, target *xtype.Type) bool {
+	return ctx.Conf.Enum.Flags && isEnum(ctx, source, target) &&
+		source.Basic && source.BasicType.Info()&types.IsInteger != 0 &&
+		target.Basic && target.BasicType.Info()&types.IsInteger != 0
+}
+
+// Build creates conversion source code for the given source and target type.
+func (*EnumFlags) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, path ErrorPath) ([]jen.Code, *xtype.JenID, *Error) {
+	stmt, nameAssign, err := BuildTargetVar(gen, ctx, sourceID, source, target, path)
+	if err != nil {
+		return nil, nil, err
+	}
+	nameVar := nameAssign.Stmt
+
+	targetEnum := target.Enum(&ctx.Conf.Enum)
+	sourceEnum := source.Enum(&ctx.Conf.Enum)
+
+	for _, e := range []*xtype.Enum{sourceEnum, targetEnum} {
+		if name, ok := variableMember(e); ok {
+			return nil, nil, NewError(fmt.Sprintf("enum:flags cannot be used with %s, because the member %s is declared as a variable.\nThe bits of variables are unknown at generation time.",
+				e.Type.String(), name))
+		}
+	}
+
+	definedKeys := ctx.DefinedEnumFields(target)
+
+	transformerMapping, err := executeTransformers(ctx.Conf.EnumMapping.Transformers, sourceEnum.Enum, targetEnum.Enum)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var known []jen.Code
+	var unmapped []string
+	sourceTargetMapping := map[interface{}]enumMapping{}
+	for _, sourceName := range sourceEnum.SortedMembers() {
+		delete(definedKeys, sourceName)
+
+		sourceValue := sourceEnum.Members[sourceName]
+		if !isFlag(sourceValue) {
+			if targetName, ok := ctx.Conf.EnumMapping.Map[sourceName]; ok {
+				return nil, nil, NewError(fmt.Sprintf("Enum %s cannot be mapped with enum:flags, because it doesn't have exactly one bit set.\nOnly single bit keys can be used in goverter:enum:map.", sourceName)).Lift(&Path{
+					SourceType: fmtEnumValue(sourceEnum, sourceName),
+					SourceID:   sourceName,
+					Prefix:     ".",
+					TargetID:   targetName,
+					TargetType: "???",
+				})
+			}
+			// zero values and combinations of flags are covered by the single flags.
+			continue
+		}
+
+		targetName, explicit := ctx.Conf.EnumMapping.Map[sourceName]
+		ok := explicit
+		if !ok {
+			targetName, ok = transformerMapping[sourceName]
+		}
+		if !ok {
+			targetName = sourceName
+		}
+
+		if previous, ok := sourceTargetMapping[sourceValue]; ok {
+			if enumTargetMismatches(previous, targetEnum, targetName) {
+				return nil, nil, enumTargetMismatchError(targetEnum, sourceName, targetName, previous, sourceValue).Lift(&Path{
+					SourceType: fmtEnumValue(sourceEnum, sourceName),
+					SourceID:   sourceName,
+					Prefix:     ".",
+					TargetID:   targetName,
+					TargetType: fmtEnumValue(targetEnum, targetName),
+				})
+			}
+			stmt = append(stmt, jen.Comment(fmt.Sprintf("Skipped %s because it duplicates %s",
+				fmtEnumValue(sourceEnum, sourceName), fmtEnumValue(sourceEnum, previous.Source))))
+			continue
+		}
+		sourceTargetMapping[sourceValue] = enumMapping{Source: sourceName, Target: targetName}
+
+		sourceQual := jen.Qual(sourceEnum.Package(sourceName), sourceName)
+		known = append(known, sourceQual.Clone())
+
+		var body jen.Code
+		if config.IsEnumAction(targetName) {
+			if !explicit {
+				unmapped = append(unmapped, fmt.Sprintf("%s -> %s", sourceName, targetName))
+			}
+			if targetName == config.EnumActionIgnore {
+				continue
+			}
+			body, err = caseAction(gen, ctx, nameVar, target, targetEnum, targetName, sourceID, path)
+		} else if _, ok := targetEnum.Members[targetName]; ok {
+			body = nameVar.Clone().Op("|=").Qual(targetEnum.Package(targetName), targetName)
+		} else {
+			err = NewError(fmt.Sprintf("Enum %s does not exist on\n    %s\n\nSee https://goverter.jmattheis.de/guide/enum", targetName, target.String))
+		}
+		if err != nil {
+			return nil, nil, err.Lift(&Path{
+				SourceType: fmtEnumValue(sourceEnum, sourceName),
+				SourceID:   sourceName,
+				Prefix:     ".",
+				TargetID:   targetName,
+				TargetType: "???",
+			})
+		}
+		stmt = append(stmt, jen.If(sourceID.Code.Clone().Op("&").Add(sourceQual).Op("!=").Lit(0)).Block(body))
+	}
+
+	for name := range definedKeys {
+		return nil, nil, NewError(fmt.Sprintf("Configured enum value %s does not exist on\n    %s", name, source.String)).
+			Lift(&Path{
+				Prefix:     ".",
+				SourceID:   name,
+				SourceType: "???",
+			})
+	}
+
+	if ctx.Conf.Enum.Exhaustive {
+		var unreached []string
+		for _, targetName := range targetEnum.SortedMembers() {
+			if isFlag(targetEnum.Members[targetName]) && !enumValueReached(sourceTargetMapping, targetEnum, targetName) {
+				unreached = append(unreached, targetName)
+			}
+		}
+		if err := exhaustiveError(unmapped, unreached); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	enumUnknown := ctx.Conf.Common.Enum.Unknown
+	if enumUnknown == "" {
+		return nil, nil, NewError("Enum detected but enum:unknown is not configured.\nSee https://goverter.jmattheis.de/guide/enum")
+	}
+	if !config.IsEnumAction(enumUnknown) {
+		return nil, nil, NewError(fmt.Sprintf("enum:unknown %s cannot be used with enum:flags, use one of %s, %s or %s.",
+			enumUnknown, config.EnumActionError, config.EnumActionIgnore, config.EnumActionPanic))
+	}
+	if enumUnknown != config.EnumActionIgnore {
+		body, err := caseAction(gen, ctx, nameVar, target, targetEnum, enumUnknown, sourceID, path)
+		if err != nil {
+			return nil, nil, err.Lift(&Path{
+				SourceID:   "@enum:unknown",
+				Prefix:     ".",
+				TargetID:   enumUnknown,
+				TargetType: "???",
+			})
+		}
+		unknownBits := sourceID.Code.Clone()
+		if len(known) > 0 {
+			unknownBits = unknownBits.Op("&^").Parens(joinOr(known))
+		}
+		stmt = append(stmt, jen.If(unknownBits.Op("!=").Lit(0)).Block(body))
+	}
+
+	return stmt, xtype.VariableID(nameVar), nil
+}
+
+func (s *EnumFlags) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, sourceID *xtype.JenID, source, target *xtype.Type, path ErrorPath) ([]jen.Code, *Error) {
+	return AssignByBuild(s, gen, ctx, assignTo, sourceID, source, target, path)
+}
+
+// isFlag returns true, if the enum value has exactly one bit set.
+func isFlag(value interface{}) bool {
+	switch v := value.(type) {
+	case int64:
+		return v > 0 && v&(v-1) == 0
+	case *big.Int:
+		return v.Sign() > 0 && v.BitLen()-1 == int(v.TrailingZeroBits())
+	default:
+		return false
+	}
+}
+
+// variableMember returns the first member of the enum that is declared as
+// variable.
+func variableMember(e *xtype.Enum) (string, bool) {
+	for _, name := range e.SortedMembers() {
+		if _, ok := e.Members[name].(enum.Variable); ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func joinOr(codes []jen.Code) *jen.Statement {
+	stmt := jen.Add(codes[0])
+	for _, code := range codes[1:] {
+		stmt = stmt.Op("|").Add(code)
+	}
+	return stmt
+}
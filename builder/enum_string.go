@@ -0,0 +1,241 @@
+package builder
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/jmattheis/goverter/config"
+	"github.com/jmattheis/goverter/enum"
+	"github.com/jmattheis/goverter/xtype"
+)
+
+// EnumString handles conversions between enums and strings.
+type EnumString struct{}
+
+// Matches returns true, if the builder can create handle the given types.
+func (*EnumString) Matches(ctx *MethodContext, source, target *xtype.Type) bool {
+	if !ctx.Conf.Enum.Enabled {
+		return false
+	}
+	return (ctx.Conf.Enum.ToString && source.Enum(&ctx.Conf.Enum).OK && isString(target)) ||
+		(ctx.Conf.Enum.FromString && isString(source) && target.Enum(&ctx.Conf.Enum).OK)
+}
+
+// Build creates conversion source code for the given source and target type.
+func (*EnumString) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, path ErrorPath) ([]jen.Code, *xtype.JenID, *Error) {
+	stmt, nameAssign, err := BuildTargetVar(gen, ctx, sourceID, source, target, path)
+	if err != nil {
+		return nil, nil, err
+	}
+	nameVar := nameAssign.Stmt
+
+	var cases []jen.Code
+	if source.Enum(&ctx.Conf.Enum).OK && isString(target) {
+		cases, err = enumToStringCases(gen, ctx, nameVar, sourceID, source, target, path)
+	} else {
+		cases, err = enumFromStringCases(gen, ctx, nameVar, sourceID, source, target, path)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stmt = append(stmt, jen.Switch(sourceID.Code).Block(cases...))
+	return stmt, xtype.VariableID(nameVar), nil
+}
+
+func (s *EnumString) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, sourceID *xtype.JenID, source, target *xtype.Type, path ErrorPath) ([]jen.Code, *Error) {
+	return AssignByBuild(s, gen, ctx, assignTo, sourceID, source, target, path)
+}
+
+func enumToStringCases(gen Generator, ctx *MethodContext, nameVar *jen.Statement, sourceID *xtype.JenID, source, target *xtype.Type, path ErrorPath) ([]jen.Code, *Error) {
+	sourceEnum := source.Enum(&ctx.Conf.Enum)
+	stringValues, err := enumStrings(ctx, sourceEnum)
+	if err != nil {
+		return nil, err
+	}
+
+	definedKeys := ctx.DefinedEnumFields(target)
+
+	var cases []jen.Code
+	var unmapped []string
+	seen := map[interface{}]enumMapping{}
+	for _, sourceName := range sourceEnum.SortedMembers() {
+		delete(definedKeys, sourceName)
+
+		value, explicit := ctx.Conf.EnumMapping.Map[sourceName]
+		if !explicit {
+			value = stringValues[sourceName]
+		}
+		if config.IsEnumAction(value) && !explicit {
+			unmapped = append(unmapped, fmt.Sprintf("%s -> %s", sourceName, value))
+		}
+
+		sourceValue := sourceEnum.Members[sourceName]
+		if previous, ok := seen[sourceValue]; ok {
+			if previous.Target != value {
+				return nil, NewError(fmt.Sprintf("Detected multiple enum source members with the same value but different string values.\n    %s(%v) -> %q\n    %s(%v) -> %q\n\nExplicitly define the string value with goverter:enum:map.",
+					previous.Source, sourceValue, previous.Target, sourceName, sourceValue, value)).Lift(&Path{
+					SourceType: fmtEnumValue(sourceEnum, sourceName),
+					SourceID:   sourceName,
+					Prefix:     ".",
+					TargetID:   value,
+					TargetType: target.String,
+				})
+			}
+			cases = append(cases, jen.Comment(fmt.Sprintf("Skipped %s because it duplicates %s", fmtEnumValue(sourceEnum, sourceName), fmtEnumValue(sourceEnum, previous.Source))))
+			continue
+		}
+		seen[sourceValue] = enumMapping{Source: sourceName, Target: value}
+
+		var body jen.Code
+		if config.IsEnumAction(value) {
+			body, err = caseAction(gen, ctx, nameVar, target, nil, value, sourceID, path)
+			if err != nil {
+				return nil, err.Lift(&Path{
+					SourceType: fmtEnumValue(sourceEnum, sourceName),
+					SourceID:   sourceName,
+					Prefix:     ".",
+					TargetID:   value,
+					TargetType: "???",
+				})
+			}
+		} else {
+			body = nameVar.Clone().Op("=").Lit(value)
+		}
+		cases = append(cases, jen.Case(jen.Qual(sourceEnum.Package(sourceName), sourceName)).Add(body))
+	}
+
+	for name := range definedKeys {
+		return nil, NewError(fmt.Sprintf("Configured enum value %s does not exist on\n    %s", name, source.String)).
+			Lift(&Path{
+				Prefix:     ".",
+				SourceID:   name,
+				SourceType: "???",
+			})
+	}
+
+	if ctx.Conf.Enum.Exhaustive {
+		if err := exhaustiveError(unmapped, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	enumUnknown := ctx.Conf.Common.Enum.Unknown
+	if enumUnknown == "" {
+		return nil, NewError("Enum detected but enum:unknown is not configured.\nSee https://goverter.jmattheis.de/guide/enum")
+	}
+	if !config.IsEnumAction(enumUnknown) {
+		return nil, NewError(fmt.Sprintf("enum:unknown %s cannot be used with enum:toString, use one of %s, %s or %s.",
+			enumUnknown, config.EnumActionError, config.EnumActionIgnore, config.EnumActionPanic))
+	}
+	body, err := caseAction(gen, ctx, nameVar, target, nil, enumUnknown, sourceID, path)
+	if err != nil {
+		return nil, err.Lift(&Path{
+			SourceID:   "@enum:unknown",
+			Prefix:     ".",
+			TargetID:   enumUnknown,
+			TargetType: "???",
+		})
+	}
+	return append(cases, jen.Default().Add(body)), nil
+}
+
+func enumFromStringCases(gen Generator, ctx *MethodContext, nameVar *jen.Statement, sourceID *xtype.JenID, source, target *xtype.Type, path ErrorPath) ([]jen.Code, *Error) {
+	targetEnum := target.Enum(&ctx.Conf.Enum)
+	stringValues, err := enumStrings(ctx, targetEnum)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping := map[string]string{}
+	for _, targetName := range targetEnum.SortedMembers() {
+		value := stringValues[targetName]
+		if previous, ok := mapping[value]; ok {
+			return nil, NewError(fmt.Sprintf("Detected multiple enum target members with the same string value %q.\n    %s\n    %s\n\nExplicitly define the mapping with goverter:enum:map.",
+				value, fmtEnumValue(targetEnum, previous), fmtEnumValue(targetEnum, targetName))).Lift(&Path{
+				SourceID:   fmt.Sprintf("%q", value),
+				SourceType: source.String,
+				Prefix:     ".",
+				TargetID:   targetName,
+				TargetType: fmtEnumValue(targetEnum, targetName),
+			})
+		}
+		mapping[value] = targetName
+	}
+	for value, targetName := range ctx.Conf.EnumMapping.Map {
+		mapping[value] = targetName
+	}
+
+	values := make([]string, 0, len(mapping))
+	for value := range mapping {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+
+	if ctx.Conf.Enum.Exhaustive {
+		reached := map[string]struct{}{}
+		for _, targetName := range mapping {
+			reached[targetName] = struct{}{}
+		}
+		var unreached []string
+		for _, targetName := range targetEnum.SortedMembers() {
+			if _, ok := reached[targetName]; !ok && !enumUnknownReached(ctx, targetEnum, targetName) {
+				unreached = append(unreached, targetName)
+			}
+		}
+		if err := exhaustiveError(nil, unreached); err != nil {
+			return nil, err
+		}
+	}
+
+	var cases []jen.Code
+	for _, value := range values {
+		targetName := mapping[value]
+		body, err := caseAction(gen, ctx, nameVar, target, targetEnum, targetName, sourceID, path)
+		if err != nil {
+			return nil, err.Lift(&Path{
+				SourceID:   fmt.Sprintf("%q", value),
+				SourceType: source.String,
+				Prefix:     ".",
+				TargetID:   targetName,
+				TargetType: "???",
+			})
+		}
+		cases = append(cases, jen.Case(jen.Lit(value)).Add(body))
+	}
+
+	enumUnknown := ctx.Conf.Common.Enum.Unknown
+	if enumUnknown == "" {
+		return nil, NewError("Enum detected but enum:unknown is not configured.\nSee https://goverter.jmattheis.de/guide/enum")
+	}
+	body, err := caseAction(gen, ctx, nameVar, target, targetEnum, enumUnknown, sourceID, path)
+	if err != nil {
+		return nil, err.Lift(&Path{
+			SourceID:   "@enum:unknown",
+			Prefix:     ".",
+			TargetID:   enumUnknown,
+			TargetType: "???",
+		})
+	}
+	return append(cases, jen.Default().Add(body)), nil
+}
+
+// enumStrings returns the string values of the enum members. The value is the
+// member name transformed by the configured enum:transform transformers.
+func enumStrings(ctx *MethodContext, e *xtype.Enum) (map[string]string, *Error) {
+	transformed, err := executeTransformers(ctx.Conf.EnumMapping.Transformers, e.Enum, enum.Enum{})
+	if err != nil {
+		return nil, err
+	}
+
+	stringValues := map[string]string{}
+	for name := range e.Members {
+		if value, ok := transformed[name]; ok {
+			stringValues[name] = value
+		} else {
+			stringValues[name] = name
+		}
+	}
+	return stringValues, nil
+}
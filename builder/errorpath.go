@@ -1,6 +1,10 @@
 package builder
 
-import "github.com/dave/jennifer/jen"
+import (
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+)
 
 type ErrorPath []ErrorElement
 
@@ -25,6 +29,7 @@ func (e ErrorPath) WrapErrorsUsing(pkg string, errStmt *jen.Statement) *jen.Stat
 		case errElmIndex:
 			args = append(args, jen.Qual(pkg, "Index").Call(elm.stmt.Clone()))
 		case errElmKey:
+			*elm.used = true
 			args = append(args, jen.Qual(pkg, "Key").Call(elm.stmt.Clone()))
 		}
 	}
@@ -33,15 +38,99 @@ func (e ErrorPath) WrapErrorsUsing(pkg string, errStmt *jen.Statement) *jen.Stat
 	return jen.Qual(pkg, "Wrap").Call(args...)
 }
 
+// String returns the path as selector, f.ex. Items[].Name.
+func (e ErrorPath) String() string {
+	var sb strings.Builder
+	for _, elm := range e {
+		switch elm := elm.(type) {
+		case errElmField:
+			if sb.Len() > 0 {
+				sb.WriteString(".")
+			}
+			sb.WriteString(string(elm))
+		case errElmIndex, errElmKey:
+			sb.WriteString("[]")
+		}
+	}
+	return sb.String()
+}
+
+// WrapErrorsPath wraps errStmt with the whole path. It's used for collected
+// errors, they aren't wrapped again by the parent conversions in the same
+// method.
+func (e ErrorPath) WrapErrorsPath(errStmt *jen.Statement) *jen.Statement {
+	path, args, ok := e.format()
+	if !ok {
+		return e.WrapErrors(errStmt)
+	}
+	args = append(append([]jen.Code{jen.Lit("error setting field " + path + ": %w")}, args...), errStmt)
+	return jen.Qual("fmt", "Errorf").Call(args...)
+}
+
+// Errorf creates an error with msg and args. msg is prefixed with the path
+// including the current indexes and keys, if the path contains a field and the
+// error isn't wrapped with the path already.
+func (e ErrorPath) Errorf(ctx *MethodContext, msg string, args ...jen.Code) *jen.Statement {
+	wrapped := ctx.Conf.WrapErrors || ctx.Conf.WrapErrorsUsing != "" || ctx.Conf.ErrorsCollect
+	if path, pathArgs, ok := e.format(); !wrapped && ok {
+		msg = path + ": " + msg
+		args = append(pathArgs, args...)
+	}
+
+	if len(args) == 0 {
+		return jen.Qual("errors", "New").Call(jen.Lit(msg))
+	}
+	return jen.Qual("fmt", "Errorf").Call(append([]jen.Code{jen.Lit(msg)}, args...)...)
+}
+
+// format returns the path as format string with the indexes and keys as
+// arguments, f.ex. Items[%d].Name. ok is false, if the path doesn't contain a
+// field.
+func (e ErrorPath) format() (path string, args []jen.Code, ok bool) {
+	var sb strings.Builder
+	for _, elm := range e {
+		switch elm := elm.(type) {
+		case errElmField:
+			if sb.Len() > 0 {
+				sb.WriteString(".")
+			}
+			sb.WriteString(string(elm))
+			ok = true
+		case errElmIndex:
+			sb.WriteString("[%d]")
+			args = append(args, elm.stmt.Clone())
+		case errElmKey:
+			*elm.used = true
+			sb.WriteString("[%v]")
+			args = append(args, elm.stmt.Clone())
+		}
+	}
+	return sb.String(), args, ok
+}
+
+// KeyUsed returns true, if the last key of the path is referenced by a
+// generated error.
+func (e ErrorPath) KeyUsed() bool {
+	for i := len(e) - 1; i >= 0; i-- {
+		if elm, ok := e[i].(errElmKey); ok {
+			return *elm.used
+		}
+	}
+	return false
+}
+
 func (e ErrorPath) Index(code *jen.Statement) ErrorPath { return append(e, errElmIndex{code}) }
-func (e ErrorPath) Key(code *jen.Statement) ErrorPath   { return append(e, errElmKey{code}) }
+func (e ErrorPath) Key(code *jen.Statement) ErrorPath   { return append(e, errElmKey{code, new(bool)}) }
 func (e ErrorPath) Field(name string) ErrorPath         { return append(e, errElmField(name)) }
 
 type ErrorElement interface{ _elm() }
 
 type (
 	errElmIndex struct{ stmt *jen.Statement }
-	errElmKey   struct{ stmt *jen.Statement }
+	errElmKey   struct {
+		stmt *jen.Statement
+		used *bool
+	}
 	errElmField string
 )
 
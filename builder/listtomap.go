@@ -0,0 +1,116 @@
+package builder
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/jmattheis/goverter/config"
+	"github.com/jmattheis/goverter/xtype"
+)
+
+// ListToMap handles array / slice to map conversions keyed by a field of
+// the list element.
+type ListToMap struct{}
+
+// Matches returns true, if the builder can create handle the given types.
+func (*ListToMap) Matches(ctx *MethodContext, source, target *xtype.Type) bool {
+	return ctx.Conf.ListToMapKey != "" && source.List && target.Map
+}
+
+// Build creates conversion source code for the given source and target type.
+func (l *ListToMap) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *xtype.JenID, *Error) {
+	ctx.SetErrorTargetVar(jen.Nil())
+	return BuildByAssign(l, gen, ctx, sourceID, source, target, errPath)
+}
+
+func (*ListToMap) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, sourceID *xtype.JenID, source, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *Error) {
+	ctx.SetErrorTargetVar(jen.Nil())
+
+	element := source.ListInner
+	if element.Pointer {
+		element = element.PointerInner
+	}
+	if !element.Struct {
+		return nil, NewError(fmt.Sprintf("Cannot use list:toMap %s, because %s is not a struct or struct pointer.", ctx.Conf.ListToMapKey, source.ListInner.String))
+	}
+	keyField, keyErr := xtype.FindExactField(element, ctx.Conf.ListToMapKey)
+	if keyErr != nil {
+		return nil, NewError(fmt.Sprintf("Cannot use list:toMap %s: %s.", ctx.Conf.ListToMapKey, keyErr.Error())).Lift(&Path{
+			SourceID:   "[]",
+			SourceType: source.ListInner.String,
+		})
+	}
+
+	index := ctx.Index()
+	errPath = errPath.Index(jen.Id(index))
+	item := sourceID.Code.Clone().Index(jen.Id(index))
+
+	var block []jen.Code
+	if source.ListInner.Pointer {
+		block = append(block, jen.If(item.Clone().Op("==").Nil()).Block(jen.Continue()))
+	}
+
+	keyBlock, keyID, err := gen.Build(ctx, xtype.OtherID(item.Clone().Dot(keyField.Name)), keyField.Type, target.MapKey, errPath)
+	if err != nil {
+		return nil, err.Lift(&Path{
+			SourceID:   "[]",
+			SourceType: source.ListInner.String,
+		}, &Path{
+			Prefix:     ".",
+			SourceID:   keyField.Name,
+			SourceType: keyField.Type.String,
+			TargetID:   "[]",
+			TargetType: "<mapkey> " + target.MapKey.String,
+		})
+	}
+	block = append(block, keyBlock...)
+
+	if ctx.Conf.ListToMapDuplicate != config.ListToMapOverwrite {
+		if !keyID.Variable {
+			name := ctx.Name(target.MapKey.ID())
+			block = append(block, jen.Id(name).Op(":=").Add(keyID.Code))
+			keyID = xtype.VariableID(jen.Id(name))
+		}
+
+		duplicate, err := listToMapDuplicate(gen, ctx, keyID, errPath)
+		if err != nil {
+			return nil, err
+		}
+		exists := jen.List(jen.Id("_"), jen.Id("ok")).Op(":=").Add(assignTo.Stmt.Clone().Index(keyID.Code.Clone()))
+		block = append(block, jen.If(exists, jen.Id("ok")).Block(duplicate))
+	}
+
+	valueStmt, err := gen.Assign(ctx, assignTo.WithIndex(keyID.Code).MustAssign(), xtype.VariableID(item), source.ListInner, target.MapValue, errPath)
+	if err != nil {
+		return nil, err.Lift(&Path{
+			SourceID:   "[]",
+			SourceType: source.ListInner.String,
+			TargetID:   "[]",
+			TargetType: "<mapvalue> " + target.MapValue.String,
+		})
+	}
+	block = append(block, valueStmt...)
+
+	result := []jen.Code{
+		assignTo.Stmt.Clone().Op("=").Make(target.TypeAsJen(), jen.Len(sourceID.Code.Clone())),
+		jen.For(jen.Id(index).Op(":=").Lit(0), jen.Id(index).Op("<").Len(sourceID.Code.Clone()), jen.Id(index).Op("++")).
+			Block(block...),
+	}
+	if source.ListFixed {
+		return result, nil
+	}
+	return []jen.Code{jen.If(sourceID.Code.Clone().Op("!=").Nil()).Block(result...)}, nil
+}
+
+func listToMapDuplicate(gen Generator, ctx *MethodContext, keyID *xtype.JenID, errPath ErrorPath) (jen.Code, *Error) {
+	if ctx.Conf.ListToMapDuplicate == config.EnumActionPanic {
+		return jen.Panic(jen.Qual("fmt", "Sprintf").Call(jen.Lit("duplicate map key: %v"), keyID.Code.Clone())), nil
+	}
+
+	errStmt := errPath.Errorf(ctx, "duplicate map key: %v", keyID.Code.Clone())
+	code, ok := gen.ReturnError(ctx, errPath, errStmt)
+	if !ok {
+		return nil, NewError(fmt.Sprintf("Cannot return %s because the explicitly defined conversion method doesn't return an error.", config.EnumActionError))
+	}
+	return code, nil
+}
@@ -0,0 +1,126 @@
+package builder
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/jmattheis/goverter/xtype"
+)
+
+// MapToSlice handles map to slice conversions of the map values.
+type MapToSlice struct{}
+
+// Matches returns true, if the builder can create handle the given types.
+func (*MapToSlice) Matches(ctx *MethodContext, source, target *xtype.Type) bool {
+	return ctx.Conf.MapToSlice && source.Map && target.List && !target.ListFixed
+}
+
+// Build creates conversion source code for the given source and target type.
+func (m *MapToSlice) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *xtype.JenID, *Error) {
+	ctx.SetErrorTargetVar(jen.Nil())
+	return BuildByAssign(m, gen, ctx, sourceID, source, target, errPath)
+}
+
+func (*MapToSlice) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, sourceID *xtype.JenID, source, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *Error) {
+	ctx.SetErrorTargetVar(jen.Nil())
+
+	var sortField *xtype.SimpleStructField
+	if ctx.Conf.MapToSliceSortBy != "" {
+		var err *Error
+		sortField, err = mapToSliceSortField(ctx, target)
+		if err != nil {
+			return nil, err
+		}
+		if !isOrdered(source.MapKey) {
+			return nil, NewError(fmt.Sprintf("Cannot use map:toSlice %s, because the map key %s cannot be ordered with <. The keys are required to sort elements with the same %s deterministically.", ctx.Conf.MapToSliceSortBy, source.MapKey.String, ctx.Conf.MapToSliceSortBy))
+		}
+	}
+
+	key, value := ctx.Map()
+	errPath = errPath.Key(jen.Id(key))
+
+	block, valueID, err := gen.Build(ctx, xtype.VariableID(jen.Id(value)), source.MapValue, target.ListInner, errPath)
+	if err != nil {
+		return nil, err.Lift(&Path{
+			SourceID:   "[]",
+			SourceType: "<mapvalue> " + source.MapValue.String,
+			TargetID:   "[]",
+			TargetType: target.ListInner.String,
+		})
+	}
+	block = append(block, assignTo.Stmt.Clone().Op("=").Append(assignTo.Stmt.Clone(), valueID.Code))
+
+	if sortField == nil {
+		// The key is only referenced by errors of the value conversion.
+		keyVar := jen.Id("_")
+		if errPath.KeyUsed() {
+			keyVar = jen.Id(key)
+		}
+		return []jen.Code{jen.If(sourceID.Code.Clone().Op("!=").Nil()).Block(
+			assignTo.Stmt.Clone().Op("=").Make(target.TypeAsJen(), jen.Lit(0), jen.Len(sourceID.Code.Clone())),
+			jen.For(jen.List(keyVar, jen.Id(value)).Op(":=").Range().Add(sourceID.Code.Clone())).Block(block...),
+		)}, nil
+	}
+
+	// The values are appended in the order of the keys, so that elements with
+	// the same sort field have a deterministic order after the stable sort.
+	keys := ctx.Name("keys")
+	i, j := ctx.Index(), ctx.Index()
+	block = append([]jen.Code{jen.Id(value).Op(":=").Add(sourceID.Code.Clone()).Index(jen.Id(key))}, block...)
+	elmI := assignTo.Stmt.Clone().Index(jen.Id(i))
+	elmJ := assignTo.Stmt.Clone().Index(jen.Id(j))
+	var less []jen.Code
+	if target.ListInner.Pointer {
+		less = append(less, jen.If(elmI.Clone().Op("==").Nil().Op("||").Add(elmJ.Clone()).Op("==").Nil()).Block(
+			jen.Return(elmI.Clone().Op("==").Nil().Op("&&").Add(elmJ.Clone()).Op("!=").Nil()),
+		))
+	}
+	less = append(less, jen.Return(elmI.Clone().Dot(sortField.Name).Op("<").Add(elmJ.Clone().Dot(sortField.Name))))
+
+	return []jen.Code{jen.If(sourceID.Code.Clone().Op("!=").Nil()).Block(
+		jen.Id(keys).Op(":=").Make(jen.Index().Add(source.MapKey.TypeAsJen()), jen.Lit(0), jen.Len(sourceID.Code.Clone())),
+		jen.For(jen.Id(key).Op(":=").Range().Add(sourceID.Code.Clone())).Block(
+			jen.Id(keys).Op("=").Append(jen.Id(keys), jen.Id(key)),
+		),
+		jen.Qual("sort", "Slice").Call(jen.Id(keys), jen.Func().Params(jen.Id(i), jen.Id(j).Int()).Bool().Block(
+			jen.Return(jen.Id(keys).Index(jen.Id(i)).Op("<").Id(keys).Index(jen.Id(j))),
+		)),
+		assignTo.Stmt.Clone().Op("=").Make(target.TypeAsJen(), jen.Lit(0), jen.Len(sourceID.Code.Clone())),
+		jen.For(jen.List(jen.Id("_"), jen.Id(key)).Op(":=").Range().Id(keys)).Block(block...),
+		jen.Qual("sort", "SliceStable").Call(assignTo.Stmt.Clone(), jen.Func().Params(jen.Id(i), jen.Id(j).Int()).Bool().Block(less...)),
+	)}, nil
+}
+
+func mapToSliceSortField(ctx *MethodContext, target *xtype.Type) (*xtype.SimpleStructField, *Error) {
+	element := target.ListInner
+	if element.Pointer {
+		element = element.PointerInner
+	}
+	if !element.Struct {
+		return nil, NewError(fmt.Sprintf("Cannot use map:toSlice %s, because %s is not a struct or struct pointer.", ctx.Conf.MapToSliceSortBy, target.ListInner.String))
+	}
+
+	field, err := xtype.FindExactField(element, ctx.Conf.MapToSliceSortBy)
+	if err != nil {
+		return nil, NewError(fmt.Sprintf("Cannot use map:toSlice %s: %s.", ctx.Conf.MapToSliceSortBy, err.Error())).Lift(&Path{
+			TargetID:   "[]",
+			TargetType: target.ListInner.String,
+		})
+	}
+	if !isOrdered(field.Type) {
+		return nil, NewError(fmt.Sprintf("Cannot use map:toSlice %s, because %s cannot be ordered with <.", ctx.Conf.MapToSliceSortBy, field.Type.String)).Lift(&Path{
+			TargetID:   "[]",
+			TargetType: target.ListInner.String,
+		}, &Path{
+			Prefix:     ".",
+			TargetID:   field.Name,
+			TargetType: field.Type.String,
+		})
+	}
+	return field, nil
+}
+
+func isOrdered(t *xtype.Type) bool {
+	return t.Basic && t.BasicType.Info()&types.IsOrdered != 0
+}
@@ -0,0 +1,183 @@
+package builder
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/jmattheis/goverter/config"
+	"github.com/jmattheis/goverter/xtype"
+)
+
+// Numeric handles conversions between different numeric basic types.
+type Numeric struct{}
+
+// Matches returns true, if the builder can create handle the given types.
+func (*Numeric) Matches(ctx *MethodContext, source, target *xtype.Type) bool {
+	if ctx.Conf.NumericConvert == "" || !source.Basic || !target.Basic ||
+		source.BasicType.Kind() == target.BasicType.Kind() {
+		return false
+	}
+	_, sourceOK := numericKinds[source.BasicType.Kind()]
+	_, targetOK := numericKinds[target.BasicType.Kind()]
+	return sourceOK && targetOK
+}
+
+// Build creates conversion source code for the given source and target type.
+func (*Numeric) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *xtype.JenID, *Error) {
+	s := numericKinds[source.BasicType.Kind()]
+	t := numericKinds[target.BasicType.Kind()]
+
+	convert := xtype.OtherID(target.TypeAsJen().Call(sourceID.Code.Clone()))
+
+	switch ctx.Conf.NumericConvert {
+	case config.NumericConvertUnchecked:
+		return nil, convert, nil
+	case config.NumericConvertWiden:
+		if !s.losslessTo(t) {
+			return nil, nil, NewError(fmt.Sprintf(`Cannot convert %s to %s without losing data.
+
+Use numeric:convert checked or numeric:convert unchecked to allow this conversion.
+
+See https://goverter.jmattheis.de/reference/numeric`, source.String, target.String))
+		}
+		return nil, convert, nil
+	}
+
+	checks := s.overflowChecks(t)
+	if len(checks) == 0 {
+		return nil, convert, nil
+	}
+
+	var stmt []jen.Code
+	if !sourceID.Variable {
+		name := ctx.Name(source.ID())
+		stmt = append(stmt, jen.Id(name).Op(":=").Add(sourceID.Code))
+		sourceID = xtype.VariableID(jen.Id(name))
+	}
+
+	condition := checks[0](sourceID.Code.Clone())
+	for _, check := range checks[1:] {
+		condition = condition.Op("||").Add(check(sourceID.Code.Clone()))
+	}
+
+	ctx.SetErrorTargetVar(xtype.ZeroValue(target.T))
+	errStmt := errPath.Errorf(ctx, "value %v does not fit into "+t.name, sourceID.Code.Clone())
+	ret, ok := gen.ReturnError(ctx, errPath, errStmt)
+	if !ok {
+		return nil, nil, NewError(fmt.Sprintf(`Cannot convert %s to %s with numeric:convert checked, because the explicitly defined conversion method doesn't return an error.`, source.String, target.String))
+	}
+	stmt = append(stmt, jen.If(condition).Block(ret))
+
+	return stmt, xtype.OtherID(target.TypeAsJen().Call(sourceID.Code.Clone())), nil
+}
+
+func (n *Numeric) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, sourceID *xtype.JenID, source, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *Error) {
+	return AssignByBuild(n, gen, ctx, assignTo, sourceID, source, target, errPath)
+}
+
+type numericKind struct {
+	name   string
+	float  bool
+	signed bool
+	// int and uint have at least 32 and at most 64 bits.
+	minBits, maxBits int
+	min, max         string
+}
+
+var numericKinds = map[types.BasicKind]numericKind{
+	types.Int:     {name: "int", signed: true, minBits: 32, maxBits: 64, min: "MinInt", max: "MaxInt"},
+	types.Int8:    {name: "int8", signed: true, minBits: 8, maxBits: 8, min: "MinInt8", max: "MaxInt8"},
+	types.Int16:   {name: "int16", signed: true, minBits: 16, maxBits: 16, min: "MinInt16", max: "MaxInt16"},
+	types.Int32:   {name: "int32", signed: true, minBits: 32, maxBits: 32, min: "MinInt32", max: "MaxInt32"},
+	types.Int64:   {name: "int64", signed: true, minBits: 64, maxBits: 64, min: "MinInt64", max: "MaxInt64"},
+	types.Uint:    {name: "uint", minBits: 32, maxBits: 64, max: "MaxUint"},
+	types.Uint8:   {name: "uint8", minBits: 8, maxBits: 8, max: "MaxUint8"},
+	types.Uint16:  {name: "uint16", minBits: 16, maxBits: 16, max: "MaxUint16"},
+	types.Uint32:  {name: "uint32", minBits: 32, maxBits: 32, max: "MaxUint32"},
+	types.Uint64:  {name: "uint64", minBits: 64, maxBits: 64, max: "MaxUint64"},
+	types.Float32: {name: "float32", float: true, signed: true, minBits: 24, maxBits: 24, max: "MaxFloat32"},
+	types.Float64: {name: "float64", float: true, signed: true, minBits: 53, maxBits: 53, max: "MaxFloat64"},
+}
+
+// valueBits returns the number of bits usable for the absolute value. For
+// floats this is the size of the mantissa.
+func (k numericKind) valueBits(bits int) int {
+	if k.signed && !k.float {
+		return bits - 1
+	}
+	return bits
+}
+
+func (k numericKind) platformDependent() bool {
+	return k.minBits != k.maxBits
+}
+
+// losslessTo returns true if every value of k can be represented by t on
+// every platform.
+func (k numericKind) losslessTo(t numericKind) bool {
+	switch {
+	case k.float:
+		return t.float && t.maxBits >= k.maxBits
+	case k.signed && !t.signed:
+		return false
+	default:
+		return t.valueBits(t.minBits) >= k.valueBits(k.maxBits)
+	}
+}
+
+// overflowChecks returns the conditions that are true if the value doesn't fit
+// into t. Conditions that can't happen are omitted.
+func (k numericKind) overflowChecks(t numericKind) []func(*jen.Statement) *jen.Statement {
+	var checks []func(*jen.Statement) *jen.Statement
+	if t.float {
+		if k.float && k.maxBits > t.maxBits {
+			limit := jen.Qual("math", t.max)
+			checks = append(checks,
+				func(v *jen.Statement) *jen.Statement { return v.Op("<").Op("-").Add(limit.Clone()) },
+				func(v *jen.Statement) *jen.Statement { return v.Op(">").Add(limit.Clone()) })
+		}
+		return checks
+	}
+
+	if k.float {
+		// NaN fails every comparison, it must be checked explicitly.
+		checks = append(checks, func(v *jen.Statement) *jen.Statement { return jen.Add(v.Clone()).Op("!=").Add(v.Clone()) })
+	}
+
+	// the limit must be representable by the source type on every platform,
+	// otherwise the value is converted to a 64 bit type.
+	cast := func(v *jen.Statement, unsigned bool) *jen.Statement {
+		if k.float || t.valueBits(t.maxBits) <= k.valueBits(k.minBits) {
+			return v
+		}
+		switch {
+		case unsigned && k.name != "uint64":
+			return jen.Uint64().Call(v)
+		case !unsigned && k.name != "int64":
+			return jen.Int64().Call(v)
+		default:
+			return v
+		}
+	}
+
+	if k.signed {
+		switch {
+		case !t.signed:
+			checks = append(checks, func(v *jen.Statement) *jen.Statement { return v.Op("<").Lit(0) })
+		case k.float || t.minBits < k.maxBits:
+			checks = append(checks, func(v *jen.Statement) *jen.Statement { return cast(v, false).Op("<").Qual("math", t.min) })
+		}
+	}
+
+	switch {
+	case k.float:
+		// math.MaxInt64 and similar are rounded up to the next power of two
+		// when converted to a float, the exclusive bound max+1 is exact.
+		checks = append(checks, func(v *jen.Statement) *jen.Statement { return v.Op(">=").Qual("math", t.max).Op("+").Lit(1) })
+	case k.valueBits(k.maxBits) > t.valueBits(t.minBits):
+		unsigned := !k.signed || !t.signed
+		checks = append(checks, func(v *jen.Statement) *jen.Statement { return cast(v, unsigned).Op(">").Qual("math", t.max) })
+	}
+	return checks
+}
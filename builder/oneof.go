@@ -0,0 +1,168 @@
+package builder
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/jmattheis/goverter/config"
+	"github.com/jmattheis/goverter/xtype"
+)
+
+// Oneof handles interface sum types like protobuf oneof fields.
+type Oneof struct{}
+
+// Matches returns true, if the builder can create handle the given types.
+func (*Oneof) Matches(ctx *MethodContext, source, target *xtype.Type) bool {
+	return ctx.Conf.Oneof.Enabled &&
+		source.Named && source.Interface && source.InterfaceType.NumMethods() > 0 &&
+		target.Named && target.Interface && target.InterfaceType.NumMethods() > 0 &&
+		!types.Identical(source.T, target.T)
+}
+
+// Build creates conversion source code for the given source and target type.
+func (o *Oneof) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *xtype.JenID, *Error) {
+	return BuildByAssign(o, gen, ctx, sourceID, source, target, errPath)
+}
+
+func (*Oneof) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, sourceID *xtype.JenID, source, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *Error) {
+	if ctx.Conf.Oneof.Unknown == "" {
+		return nil, NewError("Oneof detected but oneof:unknown is not configured.\nSee https://goverter.jmattheis.de/reference/oneof")
+	}
+
+	sourceVariants := oneofVariants(ctx, source)
+	if len(sourceVariants) == 0 {
+		return nil, NewError(fmt.Sprintf("Oneof detected but no implementations of\n    %s\nexist in the same package.\n\nDefine implementations of other packages with oneof:types.", source.String))
+	}
+	targetVariants := oneofVariants(ctx, target)
+
+	name := ctx.Name("value")
+	valueID := xtype.VariableID(jen.Id(name))
+
+	cases := []jen.Code{jen.Case(jen.Nil()).Block(assignTo.Stmt.Clone().Op("=").Nil())}
+	for _, variant := range sourceVariants {
+		lift := &Path{
+			Prefix:     ".",
+			SourceID:   "(" + variant.Name + ")",
+			SourceType: variant.Type.String,
+		}
+
+		targetVariant, err := oneofTarget(ctx, variant, targetVariants, target)
+		if err != nil {
+			return nil, err.Lift(lift)
+		}
+		lift.TargetID = "(" + targetVariant.Name + ")"
+		lift.TargetType = targetVariant.Type.String
+
+		stmt, nextID, err := gen.Build(ctx, valueID, variant.Type, targetVariant.Type, errPath)
+		if err != nil {
+			return nil, err.Lift(lift)
+		}
+		stmt = append(stmt, assignTo.Stmt.Clone().Op("=").Add(nextID.Code))
+		cases = append(cases, jen.Case(variant.Type.TypeAsJen()).Block(stmt...))
+	}
+
+	body, err := oneofUnknown(gen, ctx, valueID, errPath)
+	if err != nil {
+		return nil, err.Lift(&Path{
+			Prefix:     ".",
+			SourceID:   "@oneof:unknown",
+			TargetID:   ctx.Conf.Oneof.Unknown,
+			TargetType: "???",
+		})
+	}
+	cases = append(cases, jen.Default().Add(body))
+
+	return []jen.Code{jen.Switch(jen.Id(name).Op(":=").Add(sourceID.Code.Clone()).Assert(jen.Type())).Block(cases...)}, nil
+}
+
+func oneofTarget(ctx *MethodContext, variant *oneofVariant, targetVariants []*oneofVariant, target *xtype.Type) (*oneofVariant, *Error) {
+	if name, ok := ctx.Conf.Oneof.Target(variant.Name); ok {
+		for _, targetVariant := range targetVariants {
+			if targetVariant.Name == name {
+				return targetVariant, nil
+			}
+		}
+		return nil, NewError(fmt.Sprintf("Configured oneof implementation %s does not exist on\n    %s", name, target.String))
+	}
+
+	for _, targetVariant := range targetVariants {
+		if strings.EqualFold(oneofVariantName(variant.Name), oneofVariantName(targetVariant.Name)) {
+			return targetVariant, nil
+		}
+	}
+	return nil, NewError(fmt.Sprintf(`Cannot find an implementation of
+    %s
+for %s.
+
+Define the target implementation with oneof:map, f.ex.:
+
+    goverter:oneof:map %s TARGET`, target.String, variant.Name, variant.Name))
+}
+
+func oneofUnknown(gen Generator, ctx *MethodContext, valueID *xtype.JenID, errPath ErrorPath) (jen.Code, *Error) {
+	switch ctx.Conf.Oneof.Unknown {
+	case config.EnumActionPanic:
+		return jen.Panic(jen.Qual("fmt", "Sprintf").Call(jen.Lit("unexpected oneof type: %T"), valueID.Code.Clone())), nil
+	case config.EnumActionError:
+		errStmt := errPath.Errorf(ctx, "unexpected oneof type: %T", valueID.Code.Clone())
+		code, ok := gen.ReturnGuardError(ctx, errPath, errStmt)
+		if !ok {
+			return nil, NewError(fmt.Sprintf("Cannot return %s because the explicitly defined conversion method doesn't return an error.", config.EnumActionError))
+		}
+		return code, nil
+	default:
+		return jen.Comment("ignored"), nil
+	}
+}
+
+// oneofVariant is an implementation of an interface sum type.
+type oneofVariant struct {
+	Name string
+	Type *xtype.Type
+}
+
+// oneofVariants returns the types implementing the interface t that are
+// defined in the package of t or configured with oneof:types.
+func oneofVariants(ctx *MethodContext, t *xtype.Type) []*oneofVariant {
+	scope := t.NamedType.Obj().Pkg().Scope()
+
+	var candidates []*types.Named
+	for _, name := range scope.Names() {
+		if obj, ok := scope.Lookup(name).(*types.TypeName); ok && !obj.IsAlias() {
+			if named, ok := obj.Type().(*types.Named); ok {
+				candidates = append(candidates, named)
+			}
+		}
+	}
+	for _, named := range ctx.Conf.Oneof.Types {
+		if named.Obj().Pkg() != t.NamedType.Obj().Pkg() {
+			candidates = append(candidates, named)
+		}
+	}
+
+	var variants []*oneofVariant
+	for _, named := range candidates {
+		if !xtype.Accessible(named.Obj(), ctx.OutputPackagePath) || named.TypeParams().Len() > 0 || types.IsInterface(named) {
+			continue
+		}
+
+		switch {
+		case types.Implements(named, t.InterfaceType):
+			variants = append(variants, &oneofVariant{Name: named.Obj().Name(), Type: xtype.TypeOf(named)})
+		case types.Implements(types.NewPointer(named), t.InterfaceType):
+			variants = append(variants, &oneofVariant{Name: named.Obj().Name(), Type: xtype.TypeOf(types.NewPointer(named))})
+		}
+	}
+	return variants
+}
+
+// oneofVariantName returns the name used for matching implementations. The
+// message prefix of protobuf oneof wrappers like Event_Text is removed.
+func oneofVariantName(name string) string {
+	if i := strings.LastIndex(name, "_"); i != -1 {
+		return name[i+1:]
+	}
+	return name
+}
@@ -0,0 +1,172 @@
+package builder
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/jmattheis/goverter/xtype"
+)
+
+// Optional handles structs wrapping an optional value like sql.NullString.
+type Optional struct{}
+
+// Matches returns true, if the builder can create handle the given types.
+func (*Optional) Matches(ctx *MethodContext, source, target *xtype.Type) bool {
+	sourceOptional := optionalOf(ctx, source)
+	targetOptional := optionalOf(ctx, target)
+	switch {
+	case sourceOptional != nil && targetOptional != nil:
+		return !types.Identical(source.T, target.T)
+	case sourceOptional != nil:
+		return optionalValueMatches(sourceOptional.value, target)
+	case targetOptional != nil:
+		return optionalValueMatches(targetOptional.value, source)
+	default:
+		return false
+	}
+}
+
+// optionalValueMatches returns true, if t can be used as the value of an
+// optional. Other structs are handled by the Struct builder.
+func optionalValueMatches(value, t *xtype.Type) bool {
+	if t.Pointer {
+		t = t.PointerInner
+	}
+	return !t.Struct || types.Identical(value.T, t.T)
+}
+
+// Build creates conversion source code for the given source and target type.
+func (*Optional) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *xtype.JenID, *Error) {
+	sourceOptional := optionalOf(ctx, source)
+	targetOptional := optionalOf(ctx, target)
+
+	if sourceOptional != nil && targetOptional == nil && !target.Pointer && !ctx.Conf.UseZeroValueOnPointerInconsistency {
+		return nil, nil, NewError(fmt.Sprintf(`TypeMismatch: Cannot convert %s to %s
+It is unclear how an invalid value should be handled in the optional to non pointer conversion.
+
+You can enable useZeroValueOnPointerInconsistency to instruct goverter to use the zero value if source is invalid
+https://goverter.jmattheis.de/reference/useZeroValueOnPointerInconsistency`, source.T, target.T))
+	}
+
+	var stmt []jen.Code
+	if !sourceID.Variable && (sourceOptional != nil || source.Pointer) {
+		name := ctx.Name(source.ID())
+		stmt = append(stmt, jen.Id(name).Op(":=").Add(sourceID.Code))
+		sourceID = xtype.VariableID(jen.Id(name))
+	}
+
+	var condition *jen.Statement
+	valueID, valueSource := sourceID, source
+	lift := []*Path{}
+	switch {
+	case sourceOptional != nil:
+		condition = sourceID.Code.Clone().Dot(sourceOptional.valid)
+		valueID = xtype.OtherID(sourceID.Code.Clone().Dot(sourceOptional.field))
+		valueSource = sourceOptional.value
+		lift = append(lift, &Path{Prefix: ".", SourceID: sourceOptional.field, SourceType: valueSource.String})
+	case source.Pointer:
+		condition = sourceID.Code.Clone().Op("!=").Nil()
+		valueID = sourceID.Deref(source)
+		valueSource = source.PointerInner
+		lift = append(lift, &Path{SourceID: "*", SourceType: valueSource.String})
+	}
+
+	name := ctx.Name(target.ID())
+	stmt = append(stmt, jen.Var().Id(name).Add(target.TypeAsJen()))
+	ctx.SetErrorTargetVar(jen.Id(name))
+
+	valueTarget := target
+	switch {
+	case targetOptional != nil:
+		valueTarget = targetOptional.value
+		lift = append(lift, &Path{Prefix: ".", TargetID: targetOptional.field, TargetType: valueTarget.String})
+	case target.Pointer:
+		valueTarget = target.PointerInner
+		lift = append(lift, &Path{TargetID: "*", TargetType: valueTarget.String})
+	}
+
+	innerStmt, innerID, err := gen.Build(ctx, valueID, valueSource, valueTarget, errPath)
+	if err != nil {
+		return nil, nil, err.Lift(lift...)
+	}
+
+	switch {
+	case targetOptional != nil:
+		innerStmt = append(innerStmt,
+			jen.Id(name).Dot(targetOptional.field).Op("=").Add(innerID.Code),
+			jen.Id(name).Dot(targetOptional.valid).Op("=").True())
+	case target.Pointer:
+		pstmt, pointerID := innerID.Pointer(valueTarget, ctx.Name)
+		innerStmt = append(innerStmt, pstmt...)
+		innerStmt = append(innerStmt, jen.Id(name).Op("=").Add(pointerID.Code))
+	default:
+		innerStmt = append(innerStmt, jen.Id(name).Op("=").Add(innerID.Code))
+	}
+
+	if condition != nil {
+		stmt = append(stmt, jen.If(condition).Block(innerStmt...))
+	} else {
+		stmt = append(stmt, innerStmt...)
+	}
+	return stmt, xtype.VariableID(jen.Id(name)), nil
+}
+
+func (o *Optional) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, sourceID *xtype.JenID, source, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *Error) {
+	return AssignByBuild(o, gen, ctx, assignTo, sourceID, source, target, errPath)
+}
+
+// optional describes a struct wrapping an optional value.
+type optional struct {
+	field string
+	valid string
+	value *xtype.Type
+}
+
+// optionalOf returns the optional t describes or nil. The Null types of
+// database/sql are always detected, other wrappers are configured with
+// optional:wrapper.
+func optionalOf(ctx *MethodContext, t *xtype.Type) *optional {
+	if !t.Named || !t.Struct {
+		return nil
+	}
+	obj := t.NamedType.Obj()
+	if obj.Pkg() != nil && obj.Pkg().Path() == "database/sql" && strings.HasPrefix(obj.Name(), "Null") &&
+		t.StructType.NumFields() == 2 {
+		for i := 0; i < 2; i++ {
+			if field := t.StructType.Field(i); field.Name() != "Valid" {
+				return optionalWith(ctx, t, field.Name(), "Valid")
+			}
+		}
+	}
+
+	for _, wrapper := range ctx.Conf.OptionalWrappers {
+		if o := optionalWith(ctx, t, wrapper.Value, wrapper.Valid); o != nil {
+			return o
+		}
+	}
+	return nil
+}
+
+func optionalWith(ctx *MethodContext, t *xtype.Type, field, valid string) *optional {
+	var value *xtype.Type
+	validOK := false
+	for i := 0; i < t.StructType.NumFields(); i++ {
+		f := t.StructType.Field(i)
+		if !xtype.Accessible(f, ctx.OutputPackagePath) {
+			continue
+		}
+		switch f.Name() {
+		case field:
+			value = xtype.TypeOf(f.Type())
+		case valid:
+			basic, ok := f.Type().(*types.Basic)
+			validOK = ok && basic.Kind() == types.Bool
+		}
+	}
+	if value == nil || !validOK {
+		return nil
+	}
+	return &optional{field: field, valid: valid, value: value}
+}
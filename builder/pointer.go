@@ -17,7 +17,7 @@ func (*Pointer) Matches(_ *MethodContext, source, target *xtype.Type) bool {
 func (p *Pointer) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *xtype.JenID, *Error) {
 	ctx.SetErrorTargetVar(jen.Nil())
 	if ctx.UseConstructor && ctx.Conf.DefaultUpdate {
-		buildStmt, valueAssign, err := buildTargetVar(gen, ctx, sourceID, source, target, errPath)
+		buildStmt, valueAssign, err := BuildTargetVar(gen, ctx, sourceID, source, target, errPath)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -76,7 +76,7 @@ func (*SourcePointer) Matches(ctx *MethodContext, source, target *xtype.Type) bo
 // Build creates conversion source code for the given source and target type.
 func (s *SourcePointer) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, path ErrorPath) ([]jen.Code, *xtype.JenID, *Error) {
 	if ctx.UseConstructor && ctx.Conf.DefaultUpdate {
-		buildStmt, targetAssign, err := buildTargetVar(gen, ctx, sourceID, source, target, path)
+		buildStmt, targetAssign, err := BuildTargetVar(gen, ctx, sourceID, source, target, path)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -127,7 +127,7 @@ func (*TargetPointer) Build(gen Generator, ctx *MethodContext, sourceID *xtype.J
 	ctx.SetErrorTargetVar(jen.Nil())
 
 	if ctx.UseConstructor {
-		buildStmt, targetAssign, err := buildTargetVar(gen, ctx, sourceID, source, target, path)
+		buildStmt, targetAssign, err := BuildTargetVar(gen, ctx, sourceID, source, target, path)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -0,0 +1,197 @@
+package builder
+
+import (
+	"go/types"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/jmattheis/goverter/xtype"
+)
+
+const (
+	protoTimestampPkg = "google.golang.org/protobuf/types/known/timestamppb"
+	protoDurationPkg  = "google.golang.org/protobuf/types/known/durationpb"
+	protoWrappersPkg  = "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ProtoWellKnown handles conversions of the protobuf well-known types.
+type ProtoWellKnown struct{}
+
+// Matches returns true, if the builder can create handle the given types.
+func (*ProtoWellKnown) Matches(ctx *MethodContext, source, target *xtype.Type) bool {
+	if !ctx.Conf.ProtoWellKnown {
+		return false
+	}
+	if wk := protoWellKnownOf(source); wk != nil {
+		return wk.goType(target) || (target.Pointer && wk.goType(target.PointerInner))
+	}
+	if wk := protoWellKnownOf(target); wk != nil {
+		return wk.goType(source) || (source.Pointer && wk.goType(source.PointerInner))
+	}
+	return false
+}
+
+// Build creates conversion source code for the given source and target type.
+func (*ProtoWellKnown) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *xtype.JenID, *Error) {
+	if wk := protoWellKnownOf(source); wk != nil {
+		switch {
+		case target.Pointer:
+			return buildProtoNilCheck(ctx, sourceID, source, target, func(sourceID *xtype.JenID, name string) []jen.Code {
+				value := ctx.Name(target.PointerInner.ID())
+				return []jen.Code{
+					jen.Id(value).Op(":=").Add(wk.from(target.PointerInner, sourceID.Code.Clone())),
+					jen.Id(name).Op("=").Op("&").Id(value),
+				}
+			})
+		case wk.nilZero:
+			return buildProtoNilCheck(ctx, sourceID, source, target, func(sourceID *xtype.JenID, name string) []jen.Code {
+				return []jen.Code{jen.Id(name).Op("=").Add(wk.from(target, sourceID.Code.Clone()))}
+			})
+		default:
+			return nil, xtype.OtherID(wk.from(target, sourceID.Code.Clone())), nil
+		}
+	}
+
+	wk := protoWellKnownOf(target)
+	if source.Pointer {
+		return buildProtoNilCheck(ctx, sourceID, source, target, func(sourceID *xtype.JenID, name string) []jen.Code {
+			value := sourceID.Deref(source).Code
+			return []jen.Code{jen.Id(name).Op("=").Add(wk.to(source.PointerInner, value))}
+		})
+	}
+	return nil, xtype.OtherID(wk.to(source, sourceID.Code.Clone())), nil
+}
+
+func (p *ProtoWellKnown) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, sourceID *xtype.JenID, source, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *Error) {
+	return AssignByBuild(p, gen, ctx, assignTo, sourceID, source, target, errPath)
+}
+
+// buildProtoNilCheck declares a variable of the target type and assigns it
+// with the statements of assign if the source pointer is not nil.
+func buildProtoNilCheck(ctx *MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, assign func(sourceID *xtype.JenID, name string) []jen.Code) ([]jen.Code, *xtype.JenID, *Error) {
+	var stmt []jen.Code
+	if !sourceID.Variable {
+		name := ctx.Name(source.ID())
+		stmt = append(stmt, jen.Id(name).Op(":=").Add(sourceID.Code))
+		sourceID = xtype.VariableID(jen.Id(name))
+	}
+
+	name := ctx.Name(target.ID())
+	stmt = append(stmt,
+		jen.Var().Id(name).Add(target.TypeAsJen()),
+		jen.If(sourceID.Code.Clone().Op("!=").Nil()).Block(assign(sourceID, name)...),
+	)
+	return stmt, xtype.VariableID(jen.Id(name)), nil
+}
+
+// protoWellKnown describes the conversion of a protobuf well-known message to
+// a Go type.
+type protoWellKnown struct {
+	pkg, name string
+	// constructor creates the message from the Go value.
+	constructor string
+	// nilZero is true, if converting a nil message doesn't result in the zero
+	// value of the Go type.
+	nilZero bool
+	// kind is the basic kind of the Go value, or types.Invalid if the Go type
+	// isn't a basic type.
+	kind types.BasicKind
+	// getter returns the Go value of the message.
+	getter string
+	// goType returns true, if t is the Go type of the message.
+	goType func(t *xtype.Type) bool
+}
+
+// from converts the message code to t.
+func (wk *protoWellKnown) from(t *xtype.Type, code *jen.Statement) *jen.Statement {
+	value := code.Dot(wk.getter).Call()
+	switch {
+	case isBytes(t) && t.Named:
+		return t.TypeAsJen().Call(value)
+	case wk.kind == types.Invalid:
+		return value
+	default:
+		return castBasic(t, wk.kind, value)
+	}
+}
+
+// to converts code of type t to the message.
+func (wk *protoWellKnown) to(t *xtype.Type, code *jen.Statement) *jen.Statement {
+	switch {
+	case isBytes(t) && t.Named:
+		code = jen.Index().Byte().Call(code)
+	case wk.kind != types.Invalid:
+		code = asBasic(t, wk.kind, code)
+	}
+	return jen.Qual(wk.pkg, wk.constructor).Call(code)
+}
+
+var protoWellKnownTypes = []*protoWellKnown{
+	{
+		pkg: protoTimestampPkg, name: "Timestamp", constructor: "New", getter: "AsTime", nilZero: true,
+		goType: func(t *xtype.Type) bool { return isTimeType(t, "Time") },
+	},
+	{
+		pkg: protoDurationPkg, name: "Duration", constructor: "New", getter: "AsDuration",
+		goType: func(t *xtype.Type) bool { return isTimeType(t, "Duration") },
+	},
+	protoWrapper("DoubleValue", "Double", types.Float64),
+	protoWrapper("FloatValue", "Float", types.Float32),
+	protoWrapper("Int64Value", "Int64", types.Int64),
+	protoWrapper("UInt64Value", "UInt64", types.Uint64),
+	protoWrapper("Int32Value", "Int32", types.Int32),
+	protoWrapper("UInt32Value", "UInt32", types.Uint32),
+	protoWrapper("BoolValue", "Bool", types.Bool),
+	protoWrapper("StringValue", "String", types.String),
+	{
+		pkg: protoWrappersPkg, name: "BytesValue", constructor: "Bytes", getter: "GetValue",
+		goType: isBytes,
+	},
+}
+
+func protoWrapper(name, constructor string, kind types.BasicKind) *protoWellKnown {
+	return &protoWellKnown{
+		pkg: protoWrappersPkg, name: name, constructor: constructor, getter: "GetValue", kind: kind,
+		goType: func(t *xtype.Type) bool {
+			return t.Basic && t.BasicType.Kind() == kind && !isTimeType(t, "Duration")
+		},
+	}
+}
+
+// protoWellKnownOf returns the well-known type t points to or nil.
+func protoWellKnownOf(t *xtype.Type) *protoWellKnown {
+	if !t.Pointer || !t.PointerInner.Named {
+		return nil
+	}
+	obj := t.PointerInner.NamedType.Obj()
+	if obj.Pkg() == nil {
+		return nil
+	}
+	for _, wk := range protoWellKnownTypes {
+		if obj.Pkg().Path() == wk.pkg && obj.Name() == wk.name {
+			return wk
+		}
+	}
+	return nil
+}
+
+// protoGetter returns the name of the generated getter of the field on the
+// protobuf message pointer t.
+func protoGetter(ctx *MethodContext, t *xtype.Type, field string) (string, bool) {
+	if !ctx.Conf.ProtoWellKnown || !t.Pointer || !t.PointerInner.Named || !t.PointerInner.Struct {
+		return "", false
+	}
+	named := t.PointerInner.NamedType
+	methods := types.NewMethodSet(t.T)
+	if methods.Lookup(named.Obj().Pkg(), "ProtoReflect") == nil {
+		return "", false
+	}
+	sourceMatch, err := xtype.FindExactField(t.PointerInner, field)
+	if err != nil {
+		return "", false
+	}
+	getter := "Get" + sourceMatch.Name
+	if methods.Lookup(named.Obj().Pkg(), getter) == nil {
+		return "", false
+	}
+	return getter, true
+}
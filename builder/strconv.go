@@ -0,0 +1,131 @@
+package builder
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/jmattheis/goverter/xtype"
+)
+
+// Strconv handles conversions between strings and other basic types.
+type Strconv struct{}
+
+// Matches returns true, if the builder can create handle the given types.
+func (*Strconv) Matches(ctx *MethodContext, source, target *xtype.Type) bool {
+	if !ctx.Conf.ConvertStrconv {
+		return false
+	}
+	switch {
+	case isString(source):
+		return isBytes(target) || isStrconvBasic(target)
+	case isString(target):
+		return isBytes(source) || isStrconvBasic(source)
+	default:
+		return false
+	}
+}
+
+// Build creates conversion source code for the given source and target type.
+func (*Strconv) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *xtype.JenID, *Error) {
+	switch {
+	case isBytes(target) && !target.Named:
+		return nil, xtype.OtherID(jen.Index().Byte().Call(sourceID.Code.Clone())), nil
+	case isBytes(source), isBytes(target):
+		return nil, xtype.OtherID(target.TypeAsJen().Call(sourceID.Code.Clone())), nil
+	case isString(target):
+		return nil, xtype.OtherID(castBasic(target, types.String, formatBasic(source, sourceID.Code.Clone()))), nil
+	}
+
+	parse, kind := parseBasic(target, castBasic(source, types.String, sourceID.Code.Clone()))
+
+	ctx.SetErrorTargetVar(xtype.ZeroValue(target.T))
+	ret, ok := gen.ReturnError(ctx, errPath, jen.Id("err"))
+	if !ok {
+		return nil, nil, NewError(fmt.Sprintf(`Cannot convert %s to %s with convert:strconv, because the explicitly defined conversion method doesn't return an error.`, source.String, target.String))
+	}
+
+	name := ctx.Name(target.ID())
+	stmt := []jen.Code{
+		jen.List(jen.Id(name), jen.Err()).Op(":=").Add(parse),
+		jen.If(jen.Err().Op("!=").Nil()).Block(ret),
+	}
+	return stmt, xtype.OtherID(castBasic(target, kind, jen.Id(name))), nil
+}
+
+func (s *Strconv) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, sourceID *xtype.JenID, source, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *Error) {
+	return AssignByBuild(s, gen, ctx, assignTo, sourceID, source, target, errPath)
+}
+
+func isString(t *xtype.Type) bool {
+	return t.Basic && t.BasicType.Kind() == types.String
+}
+
+func isBytes(t *xtype.Type) bool {
+	return t.List && !t.ListFixed && t.ListInner.Basic && !t.ListInner.Named &&
+		t.ListInner.BasicType.Kind() == types.Byte
+}
+
+func isStrconvBasic(t *xtype.Type) bool {
+	if !t.Basic {
+		return false
+	}
+	if _, ok := numericKinds[t.BasicType.Kind()]; ok {
+		return true
+	}
+	return t.BasicType.Kind() == types.Bool
+}
+
+// castBasic converts code of the basic type kind to t if necessary.
+func castBasic(t *xtype.Type, kind types.BasicKind, code *jen.Statement) *jen.Statement {
+	if !t.Named && t.BasicType.Kind() == kind {
+		return code
+	}
+	return t.TypeAsJen().Call(code)
+}
+
+// formatBasic returns the strconv call formatting code of type t.
+func formatBasic(t *xtype.Type, code *jen.Statement) *jen.Statement {
+	as := func(kind types.BasicKind) *jen.Statement {
+		return asBasic(t, kind, code)
+	}
+
+	switch kind := t.BasicType.Kind(); kind {
+	case types.Bool:
+		return jen.Qual("strconv", "FormatBool").Call(as(types.Bool))
+	case types.Int:
+		return jen.Qual("strconv", "Itoa").Call(as(types.Int))
+	case types.Float32:
+		return jen.Qual("strconv", "FormatFloat").Call(as(types.Float64), jen.LitRune('g'), jen.Lit(-1), jen.Lit(32))
+	case types.Float64:
+		return jen.Qual("strconv", "FormatFloat").Call(as(types.Float64), jen.LitRune('g'), jen.Lit(-1), jen.Lit(64))
+	default:
+		if numericKinds[kind].signed {
+			return jen.Qual("strconv", "FormatInt").Call(as(types.Int64), jen.Lit(10))
+		}
+		return jen.Qual("strconv", "FormatUint").Call(as(types.Uint64), jen.Lit(10))
+	}
+}
+
+// parseBasic returns the strconv call parsing code to type t and the basic
+// type kind of the parsed value.
+func parseBasic(t *xtype.Type, code *jen.Statement) (*jen.Statement, types.BasicKind) {
+	switch kind := t.BasicType.Kind(); kind {
+	case types.Bool:
+		return jen.Qual("strconv", "ParseBool").Call(code), types.Bool
+	case types.Int:
+		return jen.Qual("strconv", "Atoi").Call(code), types.Int
+	case types.Float32:
+		return jen.Qual("strconv", "ParseFloat").Call(code, jen.Lit(32)), types.Float64
+	case types.Float64:
+		return jen.Qual("strconv", "ParseFloat").Call(code, jen.Lit(64)), types.Float64
+	case types.Uint:
+		return jen.Qual("strconv", "ParseUint").Call(code, jen.Lit(10), jen.Lit(0)), types.Uint64
+	default:
+		bits := numericKinds[kind].maxBits
+		if numericKinds[kind].signed {
+			return jen.Qual("strconv", "ParseInt").Call(code, jen.Lit(10), jen.Lit(bits)), types.Int64
+		}
+		return jen.Qual("strconv", "ParseUint").Call(code, jen.Lit(10), jen.Lit(bits)), types.Uint64
+	}
+}
@@ -1,6 +1,7 @@
 package builder
 
 import (
+	"errors"
 	"fmt"
 	"go/types"
 	"strings"
@@ -8,6 +9,7 @@ import (
 	"github.com/dave/jennifer/jen"
 	"github.com/jmattheis/goverter/config"
 	"github.com/jmattheis/goverter/method"
+	"github.com/jmattheis/goverter/report"
 	"github.com/jmattheis/goverter/xtype"
 )
 
@@ -29,28 +31,53 @@ func (s *Struct) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID,
 }
 
 func (s *Struct) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, sourceID *xtype.JenID, source, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *Error) {
-	additionalFieldSources, err := parseAutoMap(ctx, source)
+	scope, err := newFieldScope(ctx, sourceID, source, target)
 	if err != nil {
 		return nil, err
 	}
+	return s.assignFields(gen, ctx, assignTo, sourceID, source, target, scope, errPath)
+}
 
+func (s *Struct) assignFields(gen Generator, ctx *MethodContext, assignTo *AssignTo, sourceID *xtype.JenID, source, target *xtype.Type, scope *fieldScope, errPath ErrorPath) ([]jen.Code, *Error) {
 	stmt := []jen.Code{}
 
-	definedFields := ctx.DefinedFields(target)
+	definedFields := scope.definedFields(ctx, target)
 	usedSourceID := false
 	for i := 0; i < target.StructType.NumFields(); i++ {
 		targetField := target.StructType.Field(i)
+		targetTag := target.StructType.Tag(i)
 		delete(definedFields, targetField.Name())
 
-		fieldMapping := ctx.Field(target, targetField.Name())
+		fieldMapping := scope.field(ctx, target, targetField.Name())
+		fieldStart := len(stmt)
+		if fieldMapping.Condition != nil {
+			usedSourceID = true
+		}
+
+		if scope.hasNested(ctx, target, targetField.Name()) {
+			usedSourceID = true
+			nestedStmt, err := s.assignNested(gen, ctx, assignTo, targetField, targetTag, fieldMapping, sourceID, source, scope, errPath.Field(targetField.Name()))
+			if err != nil {
+				return nil, err
+			}
+			stmt = append(stmt, nestedStmt...)
+			if stmt, err = fieldCondition(gen, ctx, stmt, fieldStart, fieldMapping, targetField, sourceID, source, errPath); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		targetFieldPath := errPath.Field(targetField.Name())
 
 		if fieldMapping.Ignore {
+			ctx.Report.Add(&report.Field{Target: targetFieldPath.String(), Kind: report.KindIgnore})
 			if ctx.Conf.AnnotateUnmapped {
 				stmt = append(stmt, unmappedComment(assignTo, targetField, "ignore"))
 			}
 			continue
 		}
 		if !targetField.Exported() && ctx.Conf.IgnoreUnexported {
+			ctx.Report.Add(&report.Field{Target: targetFieldPath.String(), Kind: report.KindIgnoreUnexported})
 			if ctx.Conf.AnnotateUnmapped {
 				stmt = append(stmt, unmappedComment(assignTo, targetField, "ignoreUnexported"))
 			}
@@ -68,12 +95,25 @@ func (s *Struct) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, s
 		}
 
 		targetFieldType := xtype.TypeOf(targetField.Type())
-		targetFieldPath := errPath.Field(targetField.Name())
+
+		if value := fieldMapping.Value; value != nil {
+			ctx.Report.Add(&report.Field{Target: targetFieldPath.String(), Source: value.Raw, Kind: report.KindValue})
+			if ctx.Conf.AnnotateUnmapped {
+				stmt = append(stmt, unmappedComment(assignTo, targetField, "value"))
+			}
+			stmt = append(stmt, assignTo.Stmt.Clone().Dot(targetField.Name()).Op("=").Add(fieldValue(value)))
+			var err *Error
+			if stmt, err = fieldCondition(gen, ctx, stmt, fieldStart, fieldMapping, targetField, sourceID, source, errPath); err != nil {
+				return nil, err
+			}
+			continue
+		}
 
 		if fieldMapping.Function == nil {
 			usedSourceID = true
-			nextID, nextSource, mapStmt, lift, skip, err := mapField(gen, ctx, targetField, sourceID, source, target, additionalFieldSources, targetFieldPath)
+			fieldSource, skip, err := resolveFieldSource(ctx, targetField, targetTag, fieldMapping, scope)
 			if skip {
+				ctx.Report.Add(&report.Field{Target: targetFieldPath.String(), Kind: report.KindIgnoreMissing})
 				if ctx.Conf.AnnotateUnmapped {
 					stmt = append(stmt, unmappedComment(assignTo, targetField, "ignoreMissing"))
 				}
@@ -82,6 +122,12 @@ func (s *Struct) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, s
 			if err != nil {
 				return nil, err
 			}
+			ctx.Report.Add(&report.Field{Target: targetFieldPath.String(), Source: fieldSource.String(), Kind: fieldSource.kind})
+
+			nextID, nextSource, mapStmt, lift, err := mapField(gen, ctx, targetField, fieldSource, sourceID, source, scope, targetFieldPath)
+			if err != nil {
+				return nil, err
+			}
 			stmt = append(stmt, mapStmt...)
 
 			fieldStmt, err := gen.Assign(ctx, AssignOf(assignTo.Stmt.Clone().Dot(targetField.Name())), nextID, nextSource, targetFieldType, targetFieldPath)
@@ -99,9 +145,17 @@ func (s *Struct) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, s
 			sourceLift := []*Path{}
 			var functionCallSourceID *xtype.JenID
 			var functionCallSourceType *xtype.Type
+			reportField := &report.Field{Target: targetFieldPath.String(), Function: def.QualifiedName(), Kind: report.KindFunction}
+			ctx.Report.Add(reportField)
 			if def.Source != nil {
 				usedSourceID = true
-				nextID, nextSource, mapStmt, mapLift, _, err := mapField(gen, ctx, targetField, sourceID, source, target, additionalFieldSources, targetFieldPath)
+				fieldSource, _, err := resolveFieldSource(ctx, targetField, targetTag, fieldMapping, scope)
+				if err != nil {
+					return nil, err
+				}
+				reportField.Source = fieldSource.String()
+
+				nextID, nextSource, mapStmt, mapLift, err := mapField(gen, ctx, targetField, fieldSource, sourceID, source, scope, targetFieldPath)
 				if err != nil {
 					return nil, err
 				}
@@ -136,13 +190,18 @@ func (s *Struct) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, s
 				stmt = append(stmt, callStmt...)
 			}
 		}
+
+		var err *Error
+		if stmt, err = fieldCondition(gen, ctx, stmt, fieldStart, fieldMapping, targetField, sourceID, source, errPath); err != nil {
+			return nil, err
+		}
 	}
-	if !usedSourceID {
+	if !usedSourceID && scope.prefix == "" {
 		stmt = append(stmt, jen.Id("_").Op("=").Add(sourceID.Code.Clone()))
 	}
 
 	for name := range definedFields {
-		return nil, NewError(fmt.Sprintf("Field %q does not exist.\nRemove or adjust field settings referencing this field.", name)).Lift(&Path{
+		return nil, NewError(fmt.Sprintf("Field %q does not exist.\nRemove or adjust field settings referencing this field.", scope.prefix+name)).Lift(&Path{
 			Prefix:     ".",
 			TargetID:   name,
 			TargetType: "???",
@@ -177,57 +236,164 @@ func unmappedComment(assignTo *AssignTo, targetField *types.Var, setting string)
 	return jen.Commentf("%s: %s", assignTo.Stmt.Clone().Dot(targetField.Name()).GoString(), setting)
 }
 
-func mapField(
-	gen Generator,
-	ctx *MethodContext,
-	targetField *types.Var,
-	sourceID *xtype.JenID,
-	source, target *xtype.Type,
-	additionalFieldSources []xtype.FieldSources,
-	errPath ErrorPath,
-) (*xtype.JenID, *xtype.Type, []jen.Code, []*Path, bool, *Error) {
-	lift := []*Path{}
-	def := ctx.Field(target, targetField.Name())
-	pathString := def.Source
-	if pathString == "." {
-		lift = append(lift, &Path{
+// fieldCondition wraps the statements of the target field starting at start
+// with the goverter:map:if condition of the field.
+func fieldCondition(gen Generator, ctx *MethodContext, stmt []jen.Code, start int, fieldMapping *config.FieldMapping, targetField *types.Var, sourceID *xtype.JenID, source *xtype.Type, errPath ErrorPath) ([]jen.Code, *Error) {
+	def := fieldMapping.Condition
+	if def == nil || len(stmt) == start {
+		return stmt, nil
+	}
+
+	conditionSourceID, conditionSource := sourceID, source
+	if sourceID.ParentPointer != nil && !source.AssignableTo(def.Source) && def.Source.AssignableTo(source.AsPointer()) {
+		conditionSourceID, conditionSource = sourceID.ParentPointer, source.AsPointer()
+	}
+
+	callStmt, conditionID, err := gen.CallMethod(ctx, def, conditionSourceID, conditionSource, def.Target, errPath)
+	if err != nil {
+		return nil, err.Lift(&Path{
 			Prefix:     ".",
-			SourceID:   " ",
-			SourceType: "goverter:map . " + targetField.Name(),
+			SourceID:   "@map:if",
 			TargetID:   targetField.Name(),
 			TargetType: targetField.Type().String(),
 		})
-		return sourceID, source, nil, lift, false, nil
 	}
 
-	var path []string
-	if pathString == "" {
-		sourceMatch, err := xtype.FindField(targetField.Name(), ctx.Conf.MatchIgnoreCase, source, additionalFieldSources)
+	fieldStmt := append([]jen.Code{}, stmt[start:]...)
+	stmt = append(stmt[:start], callStmt...)
+	return append(stmt, jen.If(conditionID.Code).Block(fieldStmt...)), nil
+}
+
+// fieldValue returns the code of a value defined with goverter:value.
+func fieldValue(value *config.FieldValue) jen.Code {
+	if value.Literal != "" {
+		return jen.Id(value.Literal)
+	}
+	return jen.Qual(value.Package, value.Name)
+}
+
+// fieldSource is the resolved source of a target field.
+type fieldSource struct {
+	// identity is true for goverter:map . TARGET
+	identity bool
+	path     []string
+	kind     report.Kind
+}
+
+func (f *fieldSource) String() string {
+	if f.identity {
+		return "."
+	}
+	return strings.Join(f.path, ".")
+}
+
+func resolveFieldSource(ctx *MethodContext, targetField *types.Var, targetTag string, def *config.FieldMapping, scope *fieldScope) (*fieldSource, bool, *Error) {
+	switch def.Source {
+	case ".":
+		return &fieldSource{identity: true, kind: report.KindMap}, false, nil
+	case "":
+		sourceMatch, byTag, err := scope.match(ctx, targetField.Name(), targetTag)
 		if err != nil {
 			cause := fmt.Sprintf("Cannot match the target field with the source entry: %s.", err.Error())
 			skip := false
 			if ctx.Conf.IgnoreMissing {
 				_, skip = err.(*xtype.NoMatchError)
 			}
-			return nil, nil, nil, nil, skip, NewError(cause).Lift(&Path{
+			return nil, skip, NewError(cause).Lift(&Path{
 				Prefix:     ".",
 				SourceID:   "???",
 				TargetID:   targetField.Name(),
 				TargetType: targetField.Type().String(),
 			})
 		}
+		kind := report.KindAuto
+		switch {
+		case byTag:
+			kind = report.KindTag
+		case scope.isAutoMap(sourceMatch.Path):
+			kind = report.KindAutoMap
+		}
+		return &fieldSource{path: sourceMatch.Path, kind: kind}, false, nil
+	default:
+		return &fieldSource{path: strings.Split(def.Source, "."), kind: report.KindMap}, false, nil
+	}
+}
 
-		path = sourceMatch.Path
-	} else {
-		path = strings.Split(pathString, ".")
+func mapField(
+	gen Generator,
+	ctx *MethodContext,
+	targetField *types.Var,
+	fieldSource *fieldSource,
+	sourceID *xtype.JenID,
+	source *xtype.Type,
+	scope *fieldScope,
+	errPath ErrorPath,
+) (*xtype.JenID, *xtype.Type, []jen.Code, []*Path, *Error) {
+	lift := []*Path{}
+	if fieldSource.identity {
+		lift = append(lift, &Path{
+			Prefix:     ".",
+			SourceID:   " ",
+			SourceType: "goverter:map . " + targetField.Name(),
+			TargetID:   targetField.Name(),
+			TargetType: targetField.Type().String(),
+		})
+		return sourceID, source, nil, lift, nil
 	}
 
+	path := fieldSource.path
+
 	var condition *jen.Statement
 
 	nextIDCode := sourceID.Code
 	nextSource := source
 
+	if root := scope.root(path); root != nil {
+		nextIDCode = root.ID.Code
+		nextSource = root.Type
+		path = path[1:]
+
+		liftPath := &Path{
+			Prefix:     " ",
+			SourceID:   root.Name,
+			SourceType: root.Type.String,
+		}
+		if len(path) == 0 {
+			liftPath.TargetID = targetField.Name()
+			liftPath.TargetType = targetField.Type().String()
+		}
+		lift = append(lift, liftPath)
+	}
+
+	var parentPointer *xtype.JenID
+	if scope.root(fieldSource.path) == nil && !source.Pointer {
+		parentPointer = sourceID.ParentPointer
+	}
+
+	getterCall := false
 	for i := 0; i < len(path); i++ {
+		messageCode, message := nextIDCode, nextSource
+		if i == 0 && parentPointer != nil {
+			// the getters are defined on the pointer of the message.
+			messageCode, message = parentPointer.Code, source.AsPointer()
+		}
+		if getter, ok := protoGetter(ctx, message, path[i]); ok {
+			sourceMatch, _ := xtype.FindExactField(message.PointerInner, path[i])
+			nextSource = sourceMatch.Type
+			nextIDCode = messageCode.Clone().Dot(getter).Call()
+			getterCall = true
+			liftPath := &Path{
+				Prefix:     ".",
+				SourceID:   getter + "()",
+				SourceType: nextSource.String,
+			}
+			if i == len(path)-1 {
+				liftPath.TargetID = targetField.Name()
+				liftPath.TargetType = targetField.Type().String()
+			}
+			lift = append(lift, liftPath)
+			continue
+		}
 		if nextSource.Pointer {
 			addCondition := nextIDCode.Clone().Op("!=").Nil()
 			if condition == nil {
@@ -239,7 +405,7 @@ func mapField(
 		}
 		if !nextSource.Struct {
 			cause := fmt.Sprintf("Cannot access '%s' on %s.", path[i], nextSource.T)
-			return nil, nil, nil, nil, false, NewError(cause).Lift(&Path{
+			return nil, nil, nil, nil, NewError(cause).Lift(&Path{
 				Prefix:     ".",
 				SourceID:   path[i],
 				SourceType: "???",
@@ -264,7 +430,7 @@ func mapField(
 		}
 
 		cause := fmt.Sprintf("Cannot find the mapped field on the source entry: %s.", err.Error())
-		return nil, nil, []jen.Code{}, nil, false, NewError(cause).Lift(&Path{
+		return nil, nil, []jen.Code{}, nil, NewError(cause).Lift(&Path{
 			Prefix:     ".",
 			SourceID:   path[i],
 			SourceType: "???",
@@ -272,6 +438,9 @@ func mapField(
 	}
 
 	returnID := xtype.VariableID(nextIDCode)
+	if getterCall {
+		returnID = xtype.OtherID(nextIDCode)
+	}
 	innerStmt := []jen.Code{}
 	if nextSource.Func {
 		def, err := method.Parse(nextSource.FuncType, &method.ParseOpts{
@@ -283,12 +452,12 @@ func mapField(
 			CustomCall:        nextIDCode,
 		}, method.EmptyLocalOpts)
 		if err != nil {
-			return nil, nil, nil, nil, false, NewError(err.Error()).Lift(lift...)
+			return nil, nil, nil, nil, NewError(err.Error()).Lift(lift...)
 		}
 
 		methodCallInner, callID, callErr := gen.CallMethod(ctx, def, nil, nil, def.Target, errPath)
 		if callErr != nil {
-			return nil, nil, nil, nil, false, callErr.Lift(lift...)
+			return nil, nil, nil, nil, callErr.Lift(lift...)
 		}
 		innerStmt = methodCallInner
 		nextSource = def.Target
@@ -329,7 +498,246 @@ func mapField(
 		stmt = append(stmt, innerStmt...)
 	}
 
-	return returnID, nextSource, stmt, lift, false, nil
+	return returnID, nextSource, stmt, lift, nil
+}
+
+// fieldScope describes where the values of target fields can be found.
+type fieldScope struct {
+	// sources are used for matching fields automatically.
+	sources []xtype.FieldSources
+	// roots are the source params of a method with multiple source params.
+	roots []*SourceParam
+	// prefix is the target path of the nested struct that is currently
+	// assigned, e.g. "Address." for goverter:map Street Address.Line1.
+	prefix string
+	// autoMap contains the paths of the sources defined via goverter:autoMap.
+	autoMap map[string]struct{}
+}
+
+func newFieldScope(ctx *MethodContext, sourceID *xtype.JenID, source, target *xtype.Type) (*fieldScope, *Error) {
+	autoMap, err := parseAutoMap(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ctx.isMultiSource(source, target) {
+		scope := &fieldScope{sources: append([]xtype.FieldSources{{Type: source}}, autoMap...)}
+		scope.addAutoMap(autoMap)
+		return scope, nil
+	}
+
+	scope := &fieldScope{}
+	for i, param := range ctx.SourceParams {
+		if i == 0 {
+			// the main source may already be dereferenced
+			param = &SourceParam{Name: param.Name, ID: sourceID, Type: source}
+		}
+		scope.roots = append(scope.roots, param)
+
+		paramType := param.Type
+		if paramType.Pointer {
+			paramType = paramType.PointerInner
+		}
+		if paramType.Struct {
+			scope.sources = append(scope.sources, xtype.FieldSources{Path: []string{param.Name}, Type: paramType})
+		}
+	}
+	for i := range autoMap {
+		autoMap[i].Path = append([]string{scope.roots[0].Name}, autoMap[i].Path...)
+	}
+	scope.sources = append(scope.sources, autoMap...)
+	scope.addAutoMap(autoMap)
+	return scope, nil
+}
+
+func (s *fieldScope) addAutoMap(sources []xtype.FieldSources) {
+	s.autoMap = map[string]struct{}{}
+	for _, source := range sources {
+		s.autoMap[strings.Join(source.Path, ".")] = struct{}{}
+	}
+}
+
+func (s *fieldScope) isAutoMap(path []string) bool {
+	_, ok := s.autoMap[strings.Join(path[:len(path)-1], ".")]
+	return ok
+}
+
+// match searches the source field for the target field. With match:tag
+// fields with the same tag value take precedence over fields with the same
+// name.
+func (s *fieldScope) match(ctx *MethodContext, name, tag string) (*xtype.StructField, bool, error) {
+	if ctx.Conf.MatchTag != "" {
+		field, err := xtype.FindFieldByTag(ctx.Conf.MatchTag, xtype.TagValue(tag, ctx.Conf.MatchTag), name, s.sources)
+		if field != nil || err != nil {
+			return field, true, err
+		}
+	}
+	field, err := xtype.FindField(name, ctx.Conf.MatchIgnoreCase, s.sources)
+	var noMatch *xtype.NoMatchError
+	if len(ctx.Conf.MatchTransform) > 0 && errors.As(err, &noMatch) {
+		transformed, err := xtype.FindFieldTransformed(name, ctx.Conf.TransformMatchName, s.sources)
+		if transformed != nil || err != nil {
+			return transformed, false, err
+		}
+	}
+	return field, false, err
+}
+
+func (s *fieldScope) root(path []string) *SourceParam {
+	if len(path) == 0 {
+		return nil
+	}
+	for _, root := range s.roots {
+		if root.Name == path[0] {
+			return root
+		}
+	}
+	return nil
+}
+
+func (s *fieldScope) fields(ctx *MethodContext, target *xtype.Type) map[string]*config.FieldMapping {
+	if s.prefix == "" && ctx.FieldsTarget != target.String {
+		return nil
+	}
+	return ctx.Conf.Fields
+}
+
+func (s *fieldScope) field(ctx *MethodContext, target *xtype.Type, name string) *config.FieldMapping {
+	prop, ok := s.fields(ctx, target)[s.prefix+name]
+	if !ok {
+		return emptyMapping
+	}
+	return prop
+}
+
+func (s *fieldScope) hasNested(ctx *MethodContext, target *xtype.Type, name string) bool {
+	for path := range s.fields(ctx, target) {
+		if strings.HasPrefix(path, s.prefix+name+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *fieldScope) definedFields(ctx *MethodContext, target *xtype.Type) map[string]struct{} {
+	f := map[string]struct{}{}
+	for path := range s.fields(ctx, target) {
+		if strings.HasPrefix(path, s.prefix) {
+			name, _, _ := strings.Cut(strings.TrimPrefix(path, s.prefix), ".")
+			f[name] = struct{}{}
+		}
+	}
+	return f
+}
+
+// nested creates the scope for the nested target struct field name. The
+// remaining fields of the nested struct are matched against the source entry
+// found at sourcePath.
+func (s *fieldScope) nested(name string, sourcePath []string, source *xtype.Type) *fieldScope {
+	nested := &fieldScope{roots: s.roots, prefix: s.prefix + name + "."}
+	if source != nil {
+		nested.sources = []xtype.FieldSources{{Path: sourcePath, Type: source}}
+	}
+	return nested
+}
+
+// sourceType returns the struct type at path, or nil if the path does not
+// lead to a struct.
+func (s *fieldScope) sourceType(source *xtype.Type, path []string) *xtype.Type {
+	if root := s.root(path); root != nil {
+		source = root.Type
+		path = path[1:]
+	}
+	for _, name := range path {
+		if source.Pointer {
+			source = source.PointerInner
+		}
+		field, err := xtype.FindExactField(source, name)
+		if err != nil {
+			return nil
+		}
+		source = field.Type
+	}
+	if source.Pointer {
+		source = source.PointerInner
+	}
+	if !source.Struct {
+		return nil
+	}
+	return source
+}
+
+func (s *Struct) assignNested(
+	gen Generator,
+	ctx *MethodContext,
+	assignTo *AssignTo,
+	targetField *types.Var,
+	targetTag string,
+	fieldMapping *config.FieldMapping,
+	sourceID *xtype.JenID,
+	source *xtype.Type,
+	scope *fieldScope,
+	errPath ErrorPath,
+) ([]jen.Code, *Error) {
+	targetFieldType := xtype.TypeOf(targetField.Type())
+	lift := &Path{
+		Prefix:     ".",
+		TargetID:   targetField.Name(),
+		TargetType: targetFieldType.String,
+	}
+
+	if fieldMapping.Ignore || fieldMapping.Function != nil {
+		cause := fmt.Sprintf("Cannot configure nested fields of %q, because %q is ignored or mapped with a function.", scope.prefix+targetField.Name(), scope.prefix+targetField.Name())
+		return nil, NewError(cause).Lift(lift)
+	}
+
+	nestedTarget := targetFieldType
+	if nestedTarget.Pointer {
+		nestedTarget = nestedTarget.PointerInner
+	}
+	if !nestedTarget.Struct {
+		cause := fmt.Sprintf("Cannot configure nested fields of %q, because %s is not a struct or struct pointer.", scope.prefix+targetField.Name(), targetFieldType.String)
+		return nil, NewError(cause).Lift(lift)
+	}
+
+	var sourcePath []string
+	var nestedSource *xtype.Type
+	switch fieldMapping.Source {
+	case ".":
+		if len(scope.roots) > 0 {
+			sourcePath = []string{scope.roots[0].Name}
+		}
+		nestedSource = scope.sourceType(source, sourcePath)
+	case "":
+		match, _, err := scope.match(ctx, targetField.Name(), targetTag)
+		if err == nil {
+			sourcePath = match.Path
+			nestedSource = scope.sourceType(source, sourcePath)
+		} else if _, ok := err.(*xtype.NoMatchError); !ok {
+			cause := fmt.Sprintf("Cannot match the target field with the source entry: %s.", err.Error())
+			return nil, NewError(cause).Lift(lift)
+		}
+	default:
+		sourcePath = strings.Split(fieldMapping.Source, ".")
+		nestedSource = scope.sourceType(source, sourcePath)
+	}
+
+	fieldAssign := assignTo.Stmt.Clone().Dot(targetField.Name())
+	stmt := []jen.Code{}
+	if targetFieldType.Pointer {
+		alloc := fieldAssign.Clone().Op("=").New(nestedTarget.TypeAsJen())
+		if assignTo.Update || ctx.Conf.UpdateTarget {
+			alloc = jen.If(fieldAssign.Clone().Op("==").Nil()).Block(alloc)
+		}
+		stmt = append(stmt, alloc)
+	}
+
+	nested := scope.nested(targetField.Name(), sourcePath, nestedSource)
+	nestedStmt, err := s.assignFields(gen, ctx, assignTo.WithStmt(fieldAssign), sourceID, source, nestedTarget, nested, errPath)
+	if err != nil {
+		return nil, err.Lift(lift)
+	}
+	return append(stmt, nestedStmt...), nil
 }
 
 func parseAutoMap(ctx *MethodContext, source *xtype.Type) ([]xtype.FieldSources, *Error) {
@@ -0,0 +1,166 @@
+package builder
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/jmattheis/goverter/config"
+	"github.com/jmattheis/goverter/xtype"
+)
+
+// Time handles conversions of time.Time and time.Duration.
+type Time struct{}
+
+// Matches returns true, if the builder can create handle the given types.
+func (*Time) Matches(ctx *MethodContext, source, target *xtype.Type) bool {
+	if ctx.Conf.ConvertTime != "" {
+		if source.Pointer && isTimeType(source.PointerInner, "Time") && !target.Pointer {
+			return isTimeType(target, "Time") || timeFormatTarget(ctx, target)
+		}
+		if isTimeType(source, "Time") && timeFormatTarget(ctx, target) {
+			return true
+		}
+		if isTimeType(target, "Time") && timeFormatTarget(ctx, source) {
+			return true
+		}
+	}
+	if ctx.Conf.ConvertTimeDuration != "" {
+		return (isTimeType(source, "Duration") && isInteger(target) && !isTimeType(target, "Duration")) ||
+			(isTimeType(target, "Duration") && isInteger(source) && !isTimeType(source, "Duration"))
+	}
+	return false
+}
+
+// Build creates conversion source code for the given source and target type.
+func (*Time) Build(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *xtype.JenID, *Error) {
+	layout := timeLayout(ctx.Conf.ConvertTime)
+
+	switch {
+	case source.Pointer:
+		return buildTimeDeref(gen, ctx, sourceID, source, target, errPath)
+	case isTimeType(source, "Duration"):
+		value := sourceID.Code.Clone()
+		if unit := durationUnits[ctx.Conf.ConvertTimeDuration]; unit != "Nanosecond" {
+			value = value.Op("/").Qual("time", unit)
+		}
+		return nil, xtype.OtherID(target.TypeAsJen().Call(value)), nil
+	case isTimeType(target, "Duration"):
+		value := target.TypeAsJen().Call(sourceID.Code.Clone())
+		if unit := durationUnits[ctx.Conf.ConvertTimeDuration]; unit != "Nanosecond" {
+			value = value.Op("*").Qual("time", unit)
+		}
+		return nil, xtype.OtherID(value), nil
+	case isTimeType(source, "Time"):
+		switch ctx.Conf.ConvertTime {
+		case config.ConvertTimeUnix:
+			return nil, xtype.OtherID(castBasic(target, types.Int64, sourceID.Code.Clone().Dot("Unix").Call())), nil
+		case config.ConvertTimeUnixMilli:
+			return nil, xtype.OtherID(castBasic(target, types.Int64, sourceID.Code.Clone().Dot("UnixMilli").Call())), nil
+		default:
+			return nil, xtype.OtherID(castBasic(target, types.String, sourceID.Code.Clone().Dot("Format").Call(layout))), nil
+		}
+	}
+
+	switch ctx.Conf.ConvertTime {
+	case config.ConvertTimeUnix:
+		return nil, xtype.OtherID(jen.Qual("time", "Unix").Call(asBasic(source, types.Int64, sourceID.Code.Clone()), jen.Lit(0))), nil
+	case config.ConvertTimeUnixMilli:
+		return nil, xtype.OtherID(jen.Qual("time", "UnixMilli").Call(asBasic(source, types.Int64, sourceID.Code.Clone()))), nil
+	}
+
+	ctx.SetErrorTargetVar(xtype.ZeroValue(target.T))
+	ret, ok := gen.ReturnError(ctx, errPath, jen.Id("err"))
+	if !ok {
+		return nil, nil, timeReturnError(source, target)
+	}
+
+	name := ctx.Name(target.ID())
+	stmt := []jen.Code{
+		jen.List(jen.Id(name), jen.Err()).Op(":=").Qual("time", "Parse").Call(layout, asBasic(source, types.String, sourceID.Code.Clone())),
+		jen.If(jen.Err().Op("!=").Nil()).Block(ret),
+	}
+	return stmt, xtype.VariableID(jen.Id(name)), nil
+}
+
+func (t *Time) Assign(gen Generator, ctx *MethodContext, assignTo *AssignTo, sourceID *xtype.JenID, source, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *Error) {
+	return AssignByBuild(t, gen, ctx, assignTo, sourceID, source, target, errPath)
+}
+
+func buildTimeDeref(gen Generator, ctx *MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, errPath ErrorPath) ([]jen.Code, *xtype.JenID, *Error) {
+	ctx.SetErrorTargetVar(xtype.ZeroValue(target.T))
+	errStmt := errPath.Errorf(ctx, "time is nil")
+	ret, ok := gen.ReturnGuardError(ctx, errPath, errStmt)
+	if !ok {
+		return nil, nil, timeReturnError(source, target)
+	}
+
+	stmt := []jen.Code{jen.If(sourceID.Code.Clone().Op("==").Nil()).Block(ret)}
+	if types.Identical(source.PointerInner.T, target.T) {
+		return stmt, sourceID.Deref(source), nil
+	}
+
+	nextStmt, nextID, err := gen.Build(ctx, sourceID.Deref(source), source.PointerInner, target, errPath)
+	if err != nil {
+		return nil, nil, err.Lift(&Path{
+			SourceID:   "*",
+			SourceType: source.PointerInner.String,
+		})
+	}
+	return append(stmt, nextStmt...), nextID, nil
+}
+
+func timeReturnError(source, target *xtype.Type) *Error {
+	return NewError(fmt.Sprintf(`Cannot convert %s to %s with convert:time, because the explicitly defined conversion method doesn't return an error.`, source.String, target.String))
+}
+
+var durationUnits = map[string]string{
+	"ns": "Nanosecond",
+	"us": "Microsecond",
+	"ms": "Millisecond",
+	"s":  "Second",
+	"m":  "Minute",
+	"h":  "Hour",
+}
+
+func timeLayout(layout string) jen.Code {
+	if layout == config.ConvertTimeRFC3339 {
+		return jen.Qual("time", "RFC3339")
+	}
+	return jen.Lit(layout)
+}
+
+// timeFormatTarget returns true if time.Time can be converted to t with the
+// configured layout.
+func timeFormatTarget(ctx *MethodContext, t *xtype.Type) bool {
+	switch ctx.Conf.ConvertTime {
+	case config.ConvertTimeUnix, config.ConvertTimeUnixMilli:
+		return t.Basic && t.BasicType.Kind() == types.Int64 && !isTimeType(t, "Duration")
+	default:
+		return isString(t)
+	}
+}
+
+func isTimeType(t *xtype.Type, name string) bool {
+	if !t.Named {
+		return false
+	}
+	obj := t.NamedType.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == name
+}
+
+func isInteger(t *xtype.Type) bool {
+	if !t.Basic {
+		return false
+	}
+	kind, ok := numericKinds[t.BasicType.Kind()]
+	return ok && !kind.float
+}
+
+// asBasic converts code of type t to the basic type kind if necessary.
+func asBasic(t *xtype.Type, kind types.BasicKind, code *jen.Statement) *jen.Statement {
+	if !t.Named && t.BasicType.Kind() == kind {
+		return code
+	}
+	return jen.Id(types.Typ[kind].Name()).Call(code)
+}
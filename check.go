@@ -0,0 +1,139 @@
+package goverter
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/jmattheis/goverter/diff"
+	"github.com/jmattheis/goverter/generator"
+)
+
+// CheckConverters generates the converters and compares them byte-for-byte
+// with the files on disk. A *CheckError is returned if the files on disk
+// aren't up to date.
+func CheckConverters(c *GenerateConfig) error {
+	files, err := generateConvertersRaw(c)
+	if err != nil {
+		return err
+	}
+
+	return checkFiles(files, c.CheckExtra)
+}
+
+// CheckError describes the differences between the generated files and the
+// files on disk.
+type CheckError struct {
+	// Diffs contains a unified diff for every file with different content.
+	Diffs []string
+	// Missing are generated files that don't exist on disk.
+	Missing []string
+	// Extra are files generated by goverter that wouldn't be generated anymore.
+	Extra []string
+}
+
+func (e *CheckError) Error() string {
+	var sb strings.Builder
+	sb.WriteString("Generated files are not up to date. Run goverter gen to update them.\n")
+	for _, path := range e.Missing {
+		fmt.Fprintf(&sb, "\nMissing file: %s", path)
+	}
+	for _, path := range e.Extra {
+		fmt.Fprintf(&sb, "\nExtra file: %s", path)
+	}
+	for _, d := range e.Diffs {
+		sb.WriteString("\n")
+		sb.WriteString(strings.TrimSuffix(d, "\n"))
+	}
+	return sb.String()
+}
+
+func checkFiles(files map[string][]byte, checkExtra bool) error {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	checkErr := &CheckError{}
+	dirs := map[string]struct{}{}
+	for _, path := range paths {
+		dirs[filepath.Dir(path)] = struct{}{}
+
+		actual, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			checkErr.Missing = append(checkErr.Missing, path)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if d := diff.Unified(path, path+" (generated)", actual, files[path]); d != "" {
+			checkErr.Diffs = append(checkErr.Diffs, d)
+		}
+	}
+
+	if checkExtra {
+		for dir := range dirs {
+			extra, err := findGeneratedFiles(dir, files)
+			if err != nil {
+				return err
+			}
+			checkErr.Extra = append(checkErr.Extra, extra...)
+		}
+		sort.Strings(checkErr.Extra)
+	}
+
+	if len(checkErr.Diffs) == 0 && len(checkErr.Missing) == 0 && len(checkErr.Extra) == 0 {
+		return nil
+	}
+	return checkErr
+}
+
+// findGeneratedFiles returns the files generated by goverter inside dir that
+// are not part of known.
+func findGeneratedFiles(dir string, known map[string][]byte) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var generated []string
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		if _, ok := known[path]; ok || entry.IsDir() || filepath.Ext(path) != ".go" {
+			continue
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if isGenerated(content) {
+			generated = append(generated, path)
+		}
+	}
+	return generated, nil
+}
+
+func isGenerated(content []byte) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == generator.Header {
+			return true
+		}
+		if strings.HasPrefix(line, "package ") {
+			return false
+		}
+	}
+	return false
+}
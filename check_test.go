@@ -0,0 +1,70 @@
+package goverter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckFiles(t *testing.T) {
+	dir := t.TempDir()
+	header := "// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.\n\n"
+
+	outdated := filepath.Join(dir, "outdated.go")
+	missing := filepath.Join(dir, "missing.go")
+	extra := filepath.Join(dir, "extra.go")
+	upToDate := filepath.Join(dir, "uptodate.go")
+	handwritten := filepath.Join(dir, "handwritten.go")
+
+	require.NoError(t, os.WriteFile(outdated, []byte(header+"package x\n\nvar A = 1\n"), 0o644))
+	require.NoError(t, os.WriteFile(extra, []byte(header+"package x\n"), 0o644))
+	require.NoError(t, os.WriteFile(upToDate, []byte(header+"package x\n"), 0o644))
+	require.NoError(t, os.WriteFile(handwritten, []byte("package x\n"), 0o644))
+
+	err := checkFiles(map[string][]byte{
+		outdated: []byte(header + "package x\n\nvar A = 2\n"),
+		missing:  []byte(header + "package x\n"),
+		upToDate: []byte(header + "package x\n"),
+	}, true)
+
+	require.Equal(t, &CheckError{
+		Missing: []string{missing},
+		Extra:   []string{extra},
+		Diffs: []string{
+			"--- " + outdated + "\n" +
+				"+++ " + outdated + " (generated)\n" +
+				"@@ -2,4 +2,4 @@\n" +
+				" \n" +
+				" package x\n" +
+				" \n" +
+				"-var A = 1\n" +
+				"+var A = 2\n",
+		},
+	}, err)
+}
+
+func TestCheckFilesUpToDate(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "generated.go")
+	require.NoError(t, os.WriteFile(path, []byte("package x\n"), 0o644))
+
+	require.NoError(t, checkFiles(map[string][]byte{path: []byte("package x\n")}, true))
+}
+
+func TestCheckFilesSharedOutputDir(t *testing.T) {
+	dir := t.TempDir()
+	header := "// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.\n\n"
+
+	// a.go and b.go are generated by converters of different packages, only
+	// the converter of a.go is checked.
+	a := filepath.Join(dir, "a.go")
+	b := filepath.Join(dir, "b.go")
+	require.NoError(t, os.WriteFile(a, []byte(header+"package x\n"), 0o644))
+	require.NoError(t, os.WriteFile(b, []byte(header+"package x\n"), 0o644))
+
+	files := map[string][]byte{a: []byte(header + "package x\n")}
+	require.NoError(t, checkFiles(files, false))
+	require.Equal(t, &CheckError{Extra: []string{b}}, checkFiles(files, true))
+}
@@ -7,7 +7,13 @@ type Command interface {
 }
 
 type Generate struct {
-	Config *goverter.GenerateConfig
+	Config      *goverter.GenerateConfig
+	Diagnostics string
+}
+
+type Check struct {
+	Config      *goverter.GenerateConfig
+	Diagnostics string
 }
 
 type Help struct {
@@ -18,4 +24,5 @@ type Version struct{}
 
 func (*Help) _c()     {}
 func (*Generate) _c() {}
+func (*Check) _c()    {}
 func (*Version) _c()  {}
@@ -9,6 +9,7 @@ import (
 	"github.com/jmattheis/goverter"
 	"github.com/jmattheis/goverter/config"
 	"github.com/jmattheis/goverter/enum"
+	"github.com/jmattheis/goverter/match"
 )
 
 type Strings []string
@@ -45,8 +46,8 @@ func Parse(args []string) (Command, error) {
 	}
 
 	switch subArgs[0] {
-	case "gen":
-		return parseGen(cmd, subArgs[1:])
+	case "gen", "check":
+		return parseGen(cmd, subArgs[0], subArgs[1:])
 	case "version":
 		return &Version{}, nil
 	case "help":
@@ -56,7 +57,7 @@ func Parse(args []string) (Command, error) {
 	}
 }
 
-func parseGen(cmd string, args []string) (Command, error) {
+func parseGen(cmd, subCmd string, args []string) (Command, error) {
 	fs := flag.NewFlagSet(cmd, flag.ContinueOnError)
 	fs.SetOutput(io.Discard)
 	fs.Usage = func() {}
@@ -68,6 +69,12 @@ func parseGen(cmd string, args []string) (Command, error) {
 	buildTags := fs.String("build-tags", "goverter", "")
 	outputConstraint := fs.String("output-constraint", "!goverter", "")
 	cwd := fs.String("cwd", "", "")
+	diagnostics := fs.String("diagnostics", "text", "")
+	report := fs.String("report", "", "")
+	var checkExtra *bool
+	if subCmd == "check" {
+		checkExtra = fs.Bool("extra", false, "")
+	}
 
 	if err := fs.Parse(args); err != nil {
 		if errors.Is(err, flag.ErrHelp) {
@@ -76,6 +83,10 @@ func parseGen(cmd string, args []string) (Command, error) {
 		return nil, usageErr(err.Error(), cmd)
 	}
 
+	if *diagnostics != "text" && *diagnostics != "json" {
+		return nil, usageErr("invalid -diagnostics "+*diagnostics+", expected text or json", cmd)
+	}
+
 	patterns := fs.Args()
 
 	if len(patterns) == 0 {
@@ -87,13 +98,19 @@ func parseGen(cmd string, args []string) (Command, error) {
 		BuildTags:             *buildTags,
 		OutputBuildConstraint: *outputConstraint,
 		WorkingDir:            *cwd,
+		Report:                *report,
 		EnumTransformers:      map[string]enum.Transformer{},
+		MatchTransformers:     map[string]match.Transformer{},
 		Global: config.RawLines{
 			Lines:    global,
 			Location: "command line (-g, -global)",
 		},
 	}
-	return &Generate{Config: &c}, nil
+	if subCmd == "check" {
+		c.CheckExtra = *checkExtra
+		return &Check{Config: &c, Diagnostics: *diagnostics}, nil
+	}
+	return &Generate{Config: &c, Diagnostics: *diagnostics}, nil
 }
 
 func usageErr(err, cmd string) error {
@@ -103,9 +120,19 @@ func usageErr(err, cmd string) error {
 func usage(cmd string) string {
 	return fmt.Sprintf(`Usage:
   %s gen [OPTIONS] PACKAGE...
+  %s check [OPTIONS] PACKAGE...
   %s help
   %s version
 
+COMMANDS:
+  gen:
+      generate the converters and write them to disk.
+
+  check:
+      generate the converters and compare them with the files on disk. The
+      differences are printed as unified diff and goverter exits with code 1
+      if any generated file is outdated or missing.
+
 PACKAGE(s):
   Define the import paths goverter will use to search for converter interfaces.
   You can define multiple packages and use the special ... golang pattern to
@@ -120,6 +147,20 @@ OPTIONS:
   -cwd [value]:
       set the working directory
 
+  -diagnostics [text|json]: (default: text)
+      the format of errors printed to stderr. json prints one JSON record per
+      error with the location, converter, method, conversion path and cause.
+
+  -extra: (check only)
+      report files in the output directories that contain the goverter
+      header but aren't generated anymore. Only use it, if every converter
+      writing into these directories is checked.
+
+  -report [file]:
+      write a report describing where each target field gets its value from.
+      The report is written as JSON if the file ends with .json and as
+      Markdown otherwise.
+
   -g [value], -global [value]:
       apply settings to all defined converters. For a list of available
       settings see: https://goverter.jmattheis.de/reference/settings
@@ -133,7 +174,8 @@ Examples:
   %s gen ./example/...
   %s gen github.com/jmattheis/goverter/example/simple
   %s gen -g 'ignoreMissing no' -g 'skipCopySameType' ./simple
+  %s check ./example/...
 
 Documentation:
-  Full documentation is available here: https://goverter.jmattheis.de`, cmd, cmd, cmd, cmd, cmd, cmd, cmd)
+  Full documentation is available here: https://goverter.jmattheis.de`, cmd, cmd, cmd, cmd, cmd, cmd, cmd, cmd, cmd)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/jmattheis/goverter/cli"
 	"github.com/jmattheis/goverter/config"
 	"github.com/jmattheis/goverter/enum"
+	"github.com/jmattheis/goverter/match"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,6 +25,10 @@ func TestError(t *testing.T) {
 		{[]string{"goverter", "gen"}, "Error: missing PATTERN"},
 		{[]string{"goverter", "gen", "-u"}, "Error: flag provided but not defined: -u"},
 		{[]string{"goverter", "gen", "-g"}, "Error: flag needs an argument: -g"},
+		{[]string{"goverter", "gen", "-diagnostics", "xml", "pattern"}, "Error: invalid -diagnostics xml, expected text or json"},
+		{[]string{"goverter", "check"}, "Error: missing PATTERN"},
+		{[]string{"goverter", "check", "-u"}, "Error: flag provided but not defined: -u"},
+		{[]string{"goverter", "gen", "-extra", "pattern"}, "Error: flag provided but not defined: -extra"},
 	}
 
 	for _, test := range tests {
@@ -42,6 +47,7 @@ func TestHelp(t *testing.T) {
 		{"goverter", "--help"},
 		{"goverter", "gen", "-h"},
 		{"goverter", "gen", "--help"},
+		{"goverter", "check", "-h"},
 	}
 
 	for _, test := range tests {
@@ -67,6 +73,8 @@ func TestSuccess(t *testing.T) {
 		"-cwd", "file/path",
 		"-build-tags", "",
 		"-output-constraint", "",
+		"-diagnostics", "json",
+		"-report", "report.md",
 		"-g", "g1",
 		"-global", "g2",
 		"-g", "g3 oops",
@@ -74,17 +82,39 @@ func TestSuccess(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	expected := &cli.Generate{&goverter.GenerateConfig{
+	expected := &cli.Generate{Config: &goverter.GenerateConfig{
 		PackagePatterns:       []string{"pattern1", "pattern2"},
 		WorkingDir:            "file/path",
+		Report:                "report.md",
 		OutputBuildConstraint: "",
 		BuildTags:             "",
 		EnumTransformers:      map[string]enum.Transformer{},
+		MatchTransformers:     map[string]match.Transformer{},
 		Global: config.RawLines{
 			Location: "command line (-g, -global)",
 			Lines:    []string{"g1", "g2", "g3 oops"},
 		},
-	}}
+	}, Diagnostics: "json"}
+	require.Equal(t, expected, actual)
+}
+
+func TestCheck(t *testing.T) {
+	actual, err := cli.Parse([]string{"goverter", "check", "-extra", "-g", "g1", "pattern"})
+	require.NoError(t, err)
+
+	expected := &cli.Check{Config: &goverter.GenerateConfig{
+		PackagePatterns:       []string{"pattern"},
+		WorkingDir:            "",
+		OutputBuildConstraint: "!goverter",
+		BuildTags:             "goverter",
+		EnumTransformers:      map[string]enum.Transformer{},
+		MatchTransformers:     map[string]match.Transformer{},
+		Global: config.RawLines{
+			Location: "command line (-g, -global)",
+			Lines:    []string{"g1"},
+		},
+		CheckExtra: true,
+	}, Diagnostics: "text"}
 	require.Equal(t, expected, actual)
 }
 
@@ -92,16 +122,17 @@ func TestDefault(t *testing.T) {
 	actual, err := cli.Parse([]string{"goverter", "gen", "pattern"})
 	require.NoError(t, err)
 
-	expected := &cli.Generate{&goverter.GenerateConfig{
+	expected := &cli.Generate{Config: &goverter.GenerateConfig{
 		PackagePatterns:       []string{"pattern"},
 		WorkingDir:            "",
 		OutputBuildConstraint: "!goverter",
 		BuildTags:             "goverter",
 		EnumTransformers:      map[string]enum.Transformer{},
+		MatchTransformers:     map[string]match.Transformer{},
 		Global: config.RawLines{
 			Location: "command line (-g, -global)",
 			Lines:    nil,
 		},
-	}}
+	}, Diagnostics: "text"}
 	require.Equal(t, expected, actual)
 }
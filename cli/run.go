@@ -6,11 +6,14 @@ import (
 	"runtime/debug"
 
 	"github.com/jmattheis/goverter"
+	"github.com/jmattheis/goverter/diagnostic"
 	"github.com/jmattheis/goverter/enum"
+	"github.com/jmattheis/goverter/match"
 )
 
 type RunOpts struct {
-	EnumTransformers map[string]enum.Transformer
+	EnumTransformers  map[string]enum.Transformer
+	MatchTransformers map[string]match.Transformer
 }
 
 // Run runs the goverter cli with the given args and customizations.
@@ -26,14 +29,17 @@ func Run(args []string, opts RunOpts) {
 		_, _ = fmt.Fprintln(os.Stdout, cmd.Usage)
 		os.Exit(0)
 	case *Generate:
-		if opts.EnumTransformers != nil {
-			for key, value := range opts.EnumTransformers {
-				cmd.Config.EnumTransformers[key] = value
-			}
-		}
+		opts.apply(cmd.Config)
 
 		if err = goverter.GenerateConverters(cmd.Config); err != nil {
-			_, _ = fmt.Fprintln(os.Stderr, err)
+			printError(err, cmd.Diagnostics)
+			os.Exit(1)
+		}
+	case *Check:
+		opts.apply(cmd.Config)
+
+		if err = goverter.CheckConverters(cmd.Config); err != nil {
+			printError(err, cmd.Diagnostics)
 			os.Exit(1)
 		}
 	case *Version:
@@ -45,3 +51,20 @@ func Run(args []string, opts RunOpts) {
 		panic("unknown command")
 	}
 }
+
+func (opts RunOpts) apply(c *goverter.GenerateConfig) {
+	for key, value := range opts.EnumTransformers {
+		c.EnumTransformers[key] = value
+	}
+	for key, value := range opts.MatchTransformers {
+		c.MatchTransformers[key] = value
+	}
+}
+
+func printError(err error, diagnostics string) {
+	if diagnostics == "json" {
+		_ = diagnostic.WriteJSON(os.Stderr, err)
+		return
+	}
+	_, _ = fmt.Fprintln(os.Stderr, err)
+}
@@ -0,0 +1,38 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jmattheis/goverter/config/parse"
+)
+
+func parseListToMap(c *Common, rest string) (err error) {
+	fields := strings.Fields(rest)
+	switch len(fields) {
+	case 1:
+		c.ListToMapKey = fields[0]
+		c.ListToMapDuplicate = ListToMapOverwrite
+	case 2:
+		c.ListToMapKey = fields[0]
+		c.ListToMapDuplicate, err = parse.Enum(false, fields[1], ListToMapOverwrite, EnumActionError, EnumActionPanic)
+	default:
+		err = fmt.Errorf("expected FIELD [ACTION] but got %q", rest)
+	}
+	return err
+}
+
+func parseMapToSlice(c *Common, rest string) error {
+	fields := strings.Fields(rest)
+	switch len(fields) {
+	case 0:
+		c.MapToSlice = true
+		c.MapToSliceSortBy = ""
+	case 1:
+		c.MapToSlice = true
+		c.MapToSliceSortBy = fields[0]
+	default:
+		return fmt.Errorf("expected [FIELD] but got %q", rest)
+	}
+	return nil
+}
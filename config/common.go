@@ -3,31 +3,59 @@ package config
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/jmattheis/goverter/config/parse"
 	"github.com/jmattheis/goverter/enum"
+	"github.com/jmattheis/goverter/match"
+)
+
+const (
+	NumericConvertWiden     = "widen"
+	NumericConvertChecked   = "checked"
+	NumericConvertUnchecked = "unchecked"
+
+	ListToMapOverwrite = "@overwrite"
+
+	ConvertTimeRFC3339   = "rfc3339"
+	ConvertTimeUnix      = "unix"
+	ConvertTimeUnixMilli = "unixmilli"
 )
 
 type Common struct {
 	FieldSettings                      []string
 	WrapErrors                         bool
 	WrapErrorsUsing                    string
+	ErrorsCollect                      bool
 	IgnoreUnexported                   bool
 	IgnoreBasicZeroValueField          bool
 	IgnoreStructZeroValueField         bool
 	IgnoreNillableZeroValueField       bool
 	MatchIgnoreCase                    bool
+	MatchTag                           string
+	MatchTransform                     []match.Func
 	IgnoreMissing                      bool
 	SkipCopySameType                   bool
 	UseZeroValueOnPointerInconsistency bool
 	UseUnderlyingTypeMethods           bool
+	NumericConvert                     string
+	ConvertStrconv                     bool
+	ConvertTime                        string
+	ConvertTimeDuration                string
+	ProtoWellKnown                     bool
+	Oneof                              Oneof
+	OptionalWrappers                   []OptionalWrapper
+	ListToMapKey                       string
+	ListToMapDuplicate                 string
+	MapToSlice                         bool
+	MapToSliceSortBy                   string
 	DefaultUpdate                      bool
 	ArgContextRegex                    *regexp.Regexp
 	Enum                               enum.Config
 	AnnotateUnmapped                   bool
 }
 
-func parseCommon(c *Common, cmd, rest string) (fieldSetting bool, err error) {
+func parseCommon(ctx *context, c *Common, cmd, rest string) (fieldSetting bool, err error) {
 	switch cmd {
 	case "wrapErrors":
 		if c.WrapErrorsUsing != "" {
@@ -39,6 +67,8 @@ func parseCommon(c *Common, cmd, rest string) (fieldSetting bool, err error) {
 			return false, fmt.Errorf("cannot be used in combination with wrapErrors")
 		}
 		c.WrapErrorsUsing, err = parse.String(rest)
+	case "errors:collect":
+		c.ErrorsCollect, err = parse.Bool(rest)
 	case "ignoreUnexported":
 		fieldSetting = true
 		c.IgnoreUnexported, err = parse.Bool(rest)
@@ -58,6 +88,12 @@ func parseCommon(c *Common, cmd, rest string) (fieldSetting bool, err error) {
 	case "matchIgnoreCase":
 		fieldSetting = true
 		c.MatchIgnoreCase, err = parse.Bool(rest)
+	case "match:tag":
+		fieldSetting = true
+		c.MatchTag, err = parse.String(rest)
+	case "match:transform":
+		fieldSetting = true
+		err = parseMatchTransform(ctx, c, rest)
 	case "ignoreMissing":
 		fieldSetting = true
 		c.IgnoreMissing, err = parse.Bool(rest)
@@ -67,8 +103,41 @@ func parseCommon(c *Common, cmd, rest string) (fieldSetting bool, err error) {
 		c.UseZeroValueOnPointerInconsistency, err = parse.Bool(rest)
 	case "useUnderlyingTypeMethods":
 		c.UseUnderlyingTypeMethods, err = parse.Bool(rest)
+	case "numeric:convert":
+		c.NumericConvert, err = parse.Enum(false, rest, NumericConvertWiden, NumericConvertChecked, NumericConvertUnchecked)
+	case "convert:strconv":
+		c.ConvertStrconv, err = parse.Bool(rest)
+	case "convert:time":
+		c.ConvertTime = strings.TrimSpace(rest)
+		if c.ConvertTime == "" {
+			err = fmt.Errorf("missing layout")
+		}
+	case "convert:time:duration":
+		c.ConvertTimeDuration, err = parse.Enum(false, rest, "ns", "us", "ms", "s", "m", "h")
+	case "proto:wellknown":
+		c.ProtoWellKnown, err = parse.Bool(rest)
+	case "oneof":
+		c.Oneof.Enabled, err = parse.Bool(rest)
+	case "oneof:unknown":
+		c.Oneof.Unknown, err = parse.Enum(false, rest, EnumActionPanic, EnumActionError, EnumActionIgnore)
+	case "oneof:map":
+		err = parseOneofMap(c, rest)
+	case "optional:wrapper":
+		err = parseOptionalWrapper(c, rest)
+	case "list:toMap":
+		err = parseListToMap(c, rest)
+	case "map:toSlice":
+		err = parseMapToSlice(c, rest)
 	case "enum":
 		c.Enum.Enabled, err = parse.Bool(rest)
+	case "enum:toString":
+		c.Enum.ToString, err = parse.Bool(rest)
+	case "enum:fromString":
+		c.Enum.FromString, err = parse.Bool(rest)
+	case "enum:exhaustive":
+		c.Enum.Exhaustive, err = parse.Bool(rest)
+	case "enum:flags":
+		c.Enum.Flags, err = parse.Bool(rest)
 	case "arg:context:regex":
 		c.ArgContextRegex, err = parse.Regex(rest)
 	case "enum:unknown":
@@ -87,3 +156,9 @@ func parseCommon(c *Common, cmd, rest string) (fieldSetting bool, err error) {
 
 	return fieldSetting, err
 }
+
+// appendCopy appends elems to a copy of s. Common is copied from the converter
+// to the methods, so appending must not modify the shared backing array.
+func appendCopy[T any](s []T, elems ...T) []T {
+	return append(s[:len(s):len(s)], elems...)
+}
@@ -5,7 +5,9 @@ import (
 	"sort"
 
 	"github.com/jmattheis/goverter/config/parse"
+	"github.com/jmattheis/goverter/diagnostic"
 	"github.com/jmattheis/goverter/enum"
+	"github.com/jmattheis/goverter/match"
 	"github.com/jmattheis/goverter/pkgload"
 )
 
@@ -26,18 +28,50 @@ type RawConverter struct {
 type Raw struct {
 	Converters []RawConverter
 	Global     RawLines
+	// File is the optional goverter config file.
+	File *File
 
 	WorkDir              string
 	BuildTags            string
 	OuputBuildConstraint string
 
-	EnumTransformers map[string]enum.Transformer
+	EnumTransformers  map[string]enum.Transformer
+	MatchTransformers map[string]match.Transformer
 }
 
 type context struct {
-	Loader           *pkgload.PackageLoader
-	WorkDir          string
-	EnumTransformers map[string]enum.Transformer
+	Loader            *pkgload.PackageLoader
+	WorkDir           string
+	EnumTransformers  map[string]enum.Transformer
+	MatchTransformers map[string]match.Transformer
+}
+
+// sharedLines are the settings defined outside of the converter in the order
+// they are applied: config file global, global, config file package settings.
+type sharedLines struct {
+	Name  string
+	Lines RawLines
+}
+
+func (raw *Raw) sharedLines(pkgPath string) []sharedLines {
+	var lines []sharedLines
+	if raw.File != nil {
+		for _, global := range raw.File.Global {
+			lines = append(lines, sharedLines{Name: "global", Lines: global})
+		}
+	}
+	lines = append(lines, sharedLines{Name: "global", Lines: raw.Global})
+	if raw.File != nil {
+		for _, pkg := range raw.File.Packages {
+			if !pkg.Matches(pkgPath) {
+				continue
+			}
+			for _, pkgLines := range pkg.Lines {
+				lines = append(lines, sharedLines{Name: "package " + pkg.Pattern, Lines: pkgLines})
+			}
+		}
+	}
+	return lines
 }
 
 func Parse(raw *Raw) ([]*Converter, error) {
@@ -46,11 +80,16 @@ func Parse(raw *Raw) ([]*Converter, error) {
 		return nil, err
 	}
 
-	ctx := &context{Loader: loader, EnumTransformers: raw.EnumTransformers, WorkDir: raw.WorkDir}
+	ctx := &context{
+		Loader:            loader,
+		EnumTransformers:  raw.EnumTransformers,
+		MatchTransformers: raw.MatchTransformers,
+		WorkDir:           raw.WorkDir,
+	}
 
 	converters := []*Converter{}
 	for _, rawConverter := range raw.Converters {
-		converter, err := parseConverter(ctx, &rawConverter, raw.Global)
+		converter, err := parseConverter(ctx, &rawConverter, raw.sharedLines(rawConverter.PackagePath))
 		if err != nil {
 			return nil, err
 		}
@@ -64,12 +103,18 @@ func Parse(raw *Raw) ([]*Converter, error) {
 	return converters, nil
 }
 
-func formatLineError(lines RawLines, t, value string, err error) error {
+func formatLineError(lines RawLines, converter, method, t, value string, err error) error {
 	cmd, _ := parse.Command(value)
 	msg := `error parsing 'goverter:%s' at
     %s
     %s
 
 %s`
-	return fmt.Errorf(msg, cmd, lines.Location, t, err)
+	return &diagnostic.Error{
+		Location:  lines.Location,
+		Converter: converter,
+		Method:    method,
+		Cause:     err.Error(),
+		Message:   fmt.Sprintf(msg, cmd, lines.Location, t, err),
+	}
 }
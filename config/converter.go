@@ -110,21 +110,25 @@ func defaultOutputFile(name string) string {
 	return strings.TrimSuffix(f, ext) + ".gen" + ext
 }
 
-func parseConverter(ctx *context, rawConverter *RawConverter, global RawLines) (*Converter, error) {
+func parseConverter(ctx *context, rawConverter *RawConverter, shared []sharedLines) (*Converter, error) {
 	c, err := initConverter(ctx, rawConverter)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := parseConverterLines(ctx, c, "global", global); err != nil {
-		return nil, err
+	for _, lines := range shared {
+		if err := parseConverterLines(ctx, c, lines.Name, lines.Lines); err != nil {
+			return nil, err
+		}
 	}
 	if err := parseConverterLines(ctx, c, c.IDString(), rawConverter.Converter); err != nil {
 		return nil, err
 	}
 
-	err = parseMethods(ctx, rawConverter, c)
-	return c, err
+	if err := parseMethods(ctx, rawConverter, c); err != nil {
+		return c, err
+	}
+	return c, applyReverse(c)
 }
 
 func initConverter(ctx *context, rawConverter *RawConverter) (*Converter, error) {
@@ -157,7 +161,7 @@ func initConverter(ctx *context, rawConverter *RawConverter) (*Converter, error)
 func parseConverterLines(ctx *context, c *Converter, source string, raw RawLines) error {
 	for _, value := range raw.Lines {
 		if err := parseConverterLine(ctx, c, value); err != nil {
-			return formatLineError(raw, source, value, err)
+			return formatLineError(raw, c.IDString(), "", source, value, err)
 		}
 	}
 
@@ -226,6 +230,16 @@ func parseConverterLine(ctx *context, c *Converter, value string) (err error) {
 		var pattern enum.IDPattern
 		pattern, err = parseIDPattern(c.Package, rest)
 		c.Enum.Excludes = append(c.Enum.Excludes, pattern)
+	case "enum:members":
+		var e enum.Enum
+		e, err = parseEnumMembers(ctx, c, rest)
+		c.Enum.Declared = append(c.Enum.Declared, e)
+	case "enum:members:func":
+		var e enum.Enum
+		e, err = parseEnumMembersFunc(ctx, c, rest)
+		c.Enum.Declared = append(c.Enum.Declared, e)
+	case configOneofTypes:
+		err = parseOneofTypes(ctx, c, &c.Oneof, rest)
 	case configExtend:
 		for _, name := range strings.Fields(rest) {
 			opts := &method.ParseOpts{
@@ -243,7 +257,7 @@ func parseConverterLine(ctx *context, c *Converter, value string) (err error) {
 			c.Extend = append(c.Extend, defs...)
 		}
 	default:
-		_, err = parseCommon(&c.Common, cmd, rest)
+		_, err = parseCommon(ctx, &c.Common, cmd, rest)
 	}
 	return err
 }
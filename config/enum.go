@@ -2,11 +2,17 @@ package config
 
 import (
 	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
 	"regexp"
 	"strings"
 
 	"github.com/jmattheis/goverter/enum"
 	"github.com/jmattheis/goverter/pkgload"
+	"github.com/jmattheis/goverter/xtype"
+	"golang.org/x/tools/go/packages"
 )
 
 const (
@@ -27,6 +33,12 @@ type ConfiguredTransformer struct {
 }
 
 func parseTransformer(ctx *context, name, config string) (ConfiguredTransformer, error) {
+	if i := strings.Index(name, "|"); i != -1 {
+		// chained transformers without config, f.ex. snakeUpper|addPrefix Color_
+		config = strings.TrimSpace(name[i:] + " " + config)
+		name = name[:i]
+	}
+
 	t, ok := ctx.EnumTransformers[name]
 	if !ok {
 		t, ok = enum.DefaultTransformers[name]
@@ -68,3 +80,213 @@ func parseIDPattern(cwd, rest string) (pattern enum.IDPattern, err error) {
 	}
 	return pattern, nil
 }
+
+// parseEnumMembers parses enum:members [PACKAGE:]TYPE [PACKAGE:]REGEX.
+func parseEnumMembers(ctx *context, c *Converter, rest string) (enum.Enum, error) {
+	fields := strings.Fields(rest)
+	if len(fields) != 2 {
+		return enum.Enum{}, fmt.Errorf("expected TYPE PATTERN but got %q", rest)
+	}
+	named, err := loadNamedType(ctx, c, fields[0])
+	if err != nil {
+		return enum.Enum{}, err
+	}
+
+	pkgPath, name, err := pkgload.ParseMethodString(c.Package, fields[1])
+	if err != nil {
+		return enum.Enum{}, err
+	}
+	pattern, err := regexp.Compile(name)
+	if err != nil {
+		return enum.Enum{}, err
+	}
+	pkg := ctx.Loader.GetUncheckedPkg(pkgPath)
+	if pkg == nil || pkg.Types == nil {
+		return enum.Enum{}, fmt.Errorf("failed to load package %q", pkgPath)
+	}
+
+	e := newDeclaredEnum(named)
+	scope := pkg.Types.Scope()
+	for _, name := range scope.Names() {
+		loc := pattern.FindStringIndex(name)
+		if len(loc) != 2 || loc[0] != 0 || loc[1] != len(name) {
+			continue
+		}
+		obj := scope.Lookup(name)
+		switch obj.(type) {
+		case *types.Const, *types.Var:
+		default:
+			continue
+		}
+		if !types.Identical(obj.Type(), named) || !xtype.Accessible(obj, c.OutputPackagePath) {
+			continue
+		}
+		if err := addEnumMember(e, obj); err != nil {
+			return enum.Enum{}, err
+		}
+	}
+	if len(e.Members) == 0 {
+		return enum.Enum{}, fmt.Errorf("package %s has no constants or variables of type %s matching %q", pkgPath, named, pattern)
+	}
+	return e, nil
+}
+
+// parseEnumMembersFunc parses enum:members:func [PACKAGE:]TYPE [PACKAGE:]FUNC.
+// The members are the elements of the slice literal returned by FUNC.
+func parseEnumMembersFunc(ctx *context, c *Converter, rest string) (enum.Enum, error) {
+	fields := strings.Fields(rest)
+	if len(fields) != 2 {
+		return enum.Enum{}, fmt.Errorf("expected TYPE FUNC but got %q", rest)
+	}
+	named, err := loadNamedType(ctx, c, fields[0])
+	if err != nil {
+		return enum.Enum{}, err
+	}
+
+	pkgPath, name, err := pkgload.ParseMethodString(c.Package, fields[1])
+	if err != nil {
+		return enum.Enum{}, err
+	}
+	pkg, obj, err := ctx.Loader.GetOneRaw(pkgPath, name)
+	if err != nil {
+		return enum.Enum{}, err
+	}
+	fn, ok := obj.(*types.Func)
+	if !ok {
+		return enum.Enum{}, fmt.Errorf("%s must be a function", obj)
+	}
+
+	elements, err := returnedElements(pkg, fn)
+	if err != nil {
+		return enum.Enum{}, err
+	}
+
+	e := newDeclaredEnum(named)
+	for _, element := range elements {
+		var ident *ast.Ident
+		switch expr := element.(type) {
+		case *ast.Ident:
+			ident = expr
+		case *ast.SelectorExpr:
+			ident = expr.Sel
+		default:
+			return enum.Enum{}, fmt.Errorf("%s must only return constants or variables but returns %s", fn.Name(), types.ExprString(element))
+		}
+
+		member := pkg.TypesInfo.Uses[ident]
+		switch member.(type) {
+		case *types.Const, *types.Var:
+		default:
+			return enum.Enum{}, fmt.Errorf("%s must only return constants or variables but returns %s", fn.Name(), types.ExprString(element))
+		}
+		if !types.Identical(member.Type(), named) {
+			return enum.Enum{}, fmt.Errorf("%s returns %s of type %s but expected %s", fn.Name(), member.Name(), member.Type(), named)
+		}
+		if !xtype.Accessible(member, c.OutputPackagePath) {
+			return enum.Enum{}, fmt.Errorf("%s returns %s which must be exported", fn.Name(), member.Name())
+		}
+		if err := addEnumMember(e, member); err != nil {
+			return enum.Enum{}, err
+		}
+	}
+	if len(e.Members) == 0 {
+		return enum.Enum{}, fmt.Errorf("%s does not return any members", fn.Name())
+	}
+	return e, nil
+}
+
+func loadNamedType(ctx *context, c *Converter, fullType string) (*types.Named, error) {
+	pkg, name, err := pkgload.ParseMethodString(c.Package, fullType)
+	if err != nil {
+		return nil, err
+	}
+	_, obj, err := ctx.Loader.GetOneRaw(pkg, name)
+	if err != nil {
+		return nil, err
+	}
+	typeName, ok := obj.(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("%s must be a type", obj)
+	}
+	named, ok := typeName.Type().(*types.Named)
+	if !ok {
+		return nil, fmt.Errorf("%s must be a named type", obj)
+	}
+	return named, nil
+}
+
+func newDeclaredEnum(named *types.Named) enum.Enum {
+	return enum.Enum{Type: named, Members: map[string]any{}, Packages: map[string]string{}}
+}
+
+func addEnumMember(e enum.Enum, obj types.Object) error {
+	if _, ok := e.Members[obj.Name()]; ok {
+		return fmt.Errorf("enum member %s is declared multiple times", obj.Name())
+	}
+	switch member := obj.(type) {
+	case *types.Const:
+		e.Members[obj.Name()] = constant.Val(member.Val())
+	default:
+		e.Members[obj.Name()] = enum.Variable(obj.Name())
+	}
+	if obj.Pkg().Path() != e.Type.Obj().Pkg().Path() {
+		e.Packages[obj.Name()] = obj.Pkg().Path()
+	}
+	return nil
+}
+
+// returnedElements returns the elements of the slice literal returned by fn.
+func returnedElements(pkg *packages.Package, fn *types.Func) ([]ast.Expr, error) {
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || pkg.TypesInfo.Defs[funcDecl.Name] != fn || funcDecl.Body == nil {
+				continue
+			}
+			for _, stmt := range funcDecl.Body.List {
+				ret, ok := stmt.(*ast.ReturnStmt)
+				if !ok || len(ret.Results) != 1 {
+					continue
+				}
+				if lit, ok := sliceLiteral(pkg, ret.Results[0]); ok {
+					return lit.Elts, nil
+				}
+			}
+			return nil, fmt.Errorf("%s must return a slice literal like []T{A, B} or a package variable initialized with one", fn.Name())
+		}
+	}
+	return nil, fmt.Errorf("could not find the declaration of %s", fn.Name())
+}
+
+// sliceLiteral returns the composite literal of expr. Identifiers of package
+// variables are resolved to their initializer, like the _XValues variable
+// returned by XValues generated with enumer.
+func sliceLiteral(pkg *packages.Package, expr ast.Expr) (*ast.CompositeLit, bool) {
+	switch expr := expr.(type) {
+	case *ast.CompositeLit:
+		return expr, true
+	case *ast.Ident:
+		obj, ok := pkg.TypesInfo.Uses[expr].(*types.Var)
+		if !ok || obj.Parent() != pkg.Types.Scope() {
+			return nil, false
+		}
+		for _, file := range pkg.Syntax {
+			for _, decl := range file.Decls {
+				genDecl, ok := decl.(*ast.GenDecl)
+				if !ok || genDecl.Tok != token.VAR {
+					continue
+				}
+				for _, spec := range genDecl.Specs {
+					valueSpec := spec.(*ast.ValueSpec)
+					for i, name := range valueSpec.Names {
+						if pkg.TypesInfo.Defs[name] == obj && i < len(valueSpec.Values) {
+							lit, ok := valueSpec.Values[i].(*ast.CompositeLit)
+							return lit, ok
+						}
+					}
+				}
+			}
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,147 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the name of the goverter config file. It is read from the
+// directory containing the go.mod file.
+const FileName = "goverter.yaml"
+
+// File is the parsed content of the goverter config file.
+type File struct {
+	// Global are settings that will be applied to all converters.
+	Global []RawLines
+	// BuildTags are additional build tags used when loading packages.
+	BuildTags []string
+	// Packages are settings that will be applied to all converters inside
+	// packages matching the pattern.
+	Packages []PackageLines
+}
+
+// PackageLines are settings that are applied to converters inside packages
+// matching Pattern.
+type PackageLines struct {
+	// Pattern is the pattern as defined in the config file.
+	Pattern string
+	// ImportPath is Pattern resolved to an import path. It may end with /...
+	ImportPath string
+	Lines      []RawLines
+}
+
+// Matches returns true if the import path pkgPath matches the pattern.
+func (p *PackageLines) Matches(pkgPath string) bool {
+	if base, ok := strings.CutSuffix(p.ImportPath, "/..."); ok {
+		return pkgPath == base || strings.HasPrefix(pkgPath, base+"/")
+	}
+	return pkgPath == p.ImportPath
+}
+
+type rawFile struct {
+	Global    []yaml.Node `yaml:"global"`
+	BuildTags []string    `yaml:"buildTags"`
+	Packages  []struct {
+		Pattern  string      `yaml:"pattern"`
+		Settings []yaml.Node `yaml:"settings"`
+	} `yaml:"packages"`
+}
+
+// LoadFile reads the config file next to the go.mod file that applies to
+// workDir. nil is returned if there is no config file.
+func LoadFile(workDir string) (*File, error) {
+	modFile, err := findGoMod(workDir)
+	if err != nil || modFile == "" {
+		return nil, err
+	}
+
+	fileName := filepath.Join(filepath.Dir(modFile), FileName)
+	content, err := os.ReadFile(fileName)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	modContent, err := os.ReadFile(modFile)
+	if err != nil {
+		return nil, err
+	}
+	modulePath := modfile.ModulePath(modContent)
+
+	raw := rawFile{}
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&raw); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("error parsing %s:\n%s", fileName, err)
+	}
+
+	file := &File{BuildTags: raw.BuildTags}
+	file.Global, err = toRawLines(fileName, raw.Global)
+	if err != nil {
+		return nil, err
+	}
+	for _, pkg := range raw.Packages {
+		if pkg.Pattern == "" {
+			return nil, fmt.Errorf("error parsing %s:\npackages must define a pattern", fileName)
+		}
+		lines, err := toRawLines(fileName, pkg.Settings)
+		if err != nil {
+			return nil, err
+		}
+		file.Packages = append(file.Packages, PackageLines{
+			Pattern:    pkg.Pattern,
+			ImportPath: resolvePattern(modulePath, pkg.Pattern),
+			Lines:      lines,
+		})
+	}
+	return file, nil
+}
+
+func toRawLines(fileName string, nodes []yaml.Node) ([]RawLines, error) {
+	lines := make([]RawLines, 0, len(nodes))
+	for _, node := range nodes {
+		location := fmt.Sprintf("%s:%d", fileName, node.Line)
+		if node.Kind != yaml.ScalarNode {
+			return nil, fmt.Errorf("error parsing %s:\nsetting must be a string", location)
+		}
+		lines = append(lines, RawLines{Location: location, Lines: []string{node.Value}})
+	}
+	return lines, nil
+}
+
+func resolvePattern(modulePath, pattern string) string {
+	if pattern != "." && !strings.HasPrefix(pattern, "./") {
+		return pattern
+	}
+	return path.Join(modulePath, pattern)
+}
+
+func findGoMod(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for {
+		modFile := filepath.Join(dir, "go.mod")
+		if _, err := os.Stat(modFile); err == nil {
+			return modFile, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
@@ -0,0 +1,37 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jmattheis/goverter/match"
+)
+
+func parseMatchTransform(ctx *context, c *Common, rest string) error {
+	name, config, _ := strings.Cut(rest, " ")
+
+	t, ok := ctx.MatchTransformers[name]
+	if !ok {
+		t, ok = match.DefaultTransformers[name]
+	}
+	if !ok {
+		return fmt.Errorf("transformer %q does not exist", name)
+	}
+
+	fn, err := t(config)
+	if err != nil {
+		return fmt.Errorf("transformer %q: %w", name, err)
+	}
+
+	c.MatchTransform = appendCopy(c.MatchTransform, fn)
+	return nil
+}
+
+// TransformMatchName applies the match:transform transformers in the order
+// they were defined.
+func (c *Common) TransformMatchName(name string) string {
+	for _, transform := range c.MatchTransform {
+		name = transform(name)
+	}
+	return name
+}
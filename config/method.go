@@ -4,15 +4,20 @@ import (
 	"fmt"
 	"go/types"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/jmattheis/goverter/config/parse"
 	"github.com/jmattheis/goverter/method"
+	"github.com/jmattheis/goverter/xtype"
 )
 
 const (
 	configMap     = "map"
+	configMapIf   = "map:if"
 	configDefault = "default"
+	configBefore  = "before"
+	configAfter   = "after"
 )
 
 var StructMethodContextRegex = regexp.MustCompile(".*")
@@ -22,6 +27,8 @@ type Method struct {
 	Common
 
 	Constructor *method.Definition
+	Before      []*method.Definition
+	After       []*method.Definition
 	AutoMap     []string
 	Fields      map[string]*FieldMapping
 	EnumMapping *EnumMapping
@@ -29,14 +36,17 @@ type Method struct {
 	RawFieldSettings []string
 
 	Location    string
+	reverse     *reverse
 	updateParam string
 	localOpts   method.LocalOpts
 }
 
 type FieldMapping struct {
-	Source   string
-	Function *method.Definition
-	Ignore   bool
+	Source    string
+	Function  *method.Definition
+	Ignore    bool
+	Value     *FieldValue
+	Condition *method.Definition
 }
 
 func (m *Method) Field(targetName string) *FieldMapping {
@@ -86,9 +96,12 @@ func parseMethod(ctx *context, c *Converter, obj types.Object, rawMethod RawLine
 
 	for _, value := range rawMethod.Lines {
 		if err := parseMethodLine(ctx, c, m, value); err != nil {
-			return m, formatLineError(rawMethod, obj.String(), value, err)
+			return m, formatLineError(rawMethod, c.IDString(), obj.String(), obj.String(), value, err)
 		}
 	}
+	if m.reverse != nil {
+		m.reverse.Lines = rawMethod
+	}
 
 	def, err := method.Parse(obj, &method.ParseOpts{
 		ErrorPrefix:       "error parsing converter method",
@@ -96,14 +109,64 @@ func parseMethod(ctx *context, c *Converter, obj types.Object, rawMethod RawLine
 		Converter:         nil,
 		OutputPackagePath: c.OutputPackagePath,
 		Params:            method.ParamsRequired,
+		ParamsMultiSource: true,
 		ContextMatch:      m.ArgContextRegex,
 		Generated:         true,
 		UpdateParam:       m.updateParam,
 	}, m.localOpts)
 
 	m.Definition = def
+	if err != nil {
+		return m, err
+	}
 
-	return m, err
+	return m, checkFields(m, rawMethod, c.IDString(), obj.String())
+}
+
+// checkFields validates the goverter:value and goverter:map:if settings.
+func checkFields(m *Method, rawMethod RawLines, converter, method string) error {
+	target := m.Target
+	if target.Pointer {
+		target = target.PointerInner
+	}
+
+	names := make([]string, 0, len(m.Fields))
+	for name := range m.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		field := m.Fields[name]
+		if field.Condition != nil && field.Ignore {
+			line := configMapIf + " " + name + " " + field.Condition.Name
+			return formatLineError(rawMethod, converter, method, method, line, fmt.Errorf("cannot be combined with goverter:ignore for field %q", name))
+		}
+		if field.Value == nil {
+			continue
+		}
+		line := configValue + " " + name + " " + field.Value.Raw
+
+		var err error
+		switch {
+		case field.Source != "" || field.Function != nil || field.Ignore:
+			err = fmt.Errorf("cannot be combined with goverter:map or goverter:ignore for field %q", name)
+		case !target.Struct:
+			err = fmt.Errorf("target %s must be a struct", m.Target.String)
+		default:
+			var targetField *xtype.SimpleStructField
+			targetField, err = xtype.FindExactField(target, name)
+			if err == nil {
+				err = field.Value.check(targetField.Type.T)
+			} else {
+				err = fmt.Errorf("%s on %s", err, target.String)
+			}
+		}
+		if err != nil {
+			return formatLineError(rawMethod, converter, method, method, line, err)
+		}
+	}
+	return nil
 }
 
 func parseMethodLine(ctx *context, c *Converter, m *Method, value string) (err error) {
@@ -131,6 +194,22 @@ func parseMethodLine(ctx *context, c *Converter, m *Method, value string) (err e
 			}
 			f.Function, err = ctx.Loader.GetOne(c.Package, custom, opts)
 		}
+	case configMapIf:
+		fieldSetting = true
+		var target string
+		var condition *method.Definition
+		target, condition, err = parseMapIf(ctx, c, m, rest)
+		if err == nil {
+			m.Field(target).Condition = condition
+		}
+	case configValue:
+		fieldSetting = true
+		var target string
+		var v *FieldValue
+		target, v, err = parseValue(ctx, c, rest)
+		if err == nil {
+			m.Field(target).Value = v
+		}
 	case "ignore":
 		fieldSetting = true
 		fields := strings.Fields(rest)
@@ -139,6 +218,10 @@ func parseMethodLine(ctx *context, c *Converter, m *Method, value string) (err e
 		}
 	case "update":
 		m.updateParam, err = parse.String(rest)
+	case "reverse":
+		var name string
+		name, err = parse.String(rest)
+		m.reverse = &reverse{Name: name, Value: value}
 	case "context":
 		var key string
 		key, err = parse.String(rest)
@@ -180,8 +263,26 @@ func parseMethodLine(ctx *context, c *Converter, m *Method, value string) (err e
 			ContextMatch:      m.ArgContextRegex,
 		}
 		m.Constructor, err = ctx.Loader.GetOne(c.Package, rest, opts)
+	case configBefore, configAfter:
+		opts := &method.ParseOpts{
+			ErrorPrefix:       "error parsing type",
+			OutputPackagePath: c.OutputPackagePath,
+			Converter:         c.typeForMethod(),
+			Params:            method.ParamsRequired,
+			ContextMatch:      m.ArgContextRegex,
+			TargetParam:       true,
+		}
+		var hook *method.Definition
+		hook, err = ctx.Loader.GetOne(c.Package, rest, opts)
+		if cmd == configBefore {
+			m.Before = append(m.Before, hook)
+		} else {
+			m.After = append(m.After, hook)
+		}
+	case configOneofTypes:
+		err = parseOneofTypes(ctx, c, &m.Oneof, rest)
 	default:
-		fieldSetting, err = parseCommon(&m.Common, cmd, rest)
+		fieldSetting, err = parseCommon(ctx, &m.Common, cmd, rest)
 	}
 	if fieldSetting {
 		m.RawFieldSettings = append(m.RawFieldSettings, value)
@@ -189,6 +290,28 @@ func parseMethodLine(ctx *context, c *Converter, m *Method, value string) (err e
 	return err
 }
 
+func parseMapIf(ctx *context, c *Converter, m *Method, rest string) (string, *method.Definition, error) {
+	fields := strings.Fields(rest)
+	if len(fields) != 2 {
+		return "", nil, fmt.Errorf("expected FIELD FUNC but got %q", rest)
+	}
+	opts := &method.ParseOpts{
+		ErrorPrefix:       "error parsing type",
+		OutputPackagePath: c.OutputPackagePath,
+		Converter:         c.typeForMethod(),
+		Params:            method.ParamsRequired,
+		ContextMatch:      m.ArgContextRegex,
+	}
+	condition, err := ctx.Loader.GetOne(c.Package, fields[1], opts)
+	if err != nil {
+		return "", nil, err
+	}
+	if basic, ok := condition.Target.T.Underlying().(*types.Basic); !ok || basic.Kind() != types.Bool || condition.ReturnError {
+		return "", nil, fmt.Errorf("%s must return bool", condition.ID)
+	}
+	return fields[0], condition, nil
+}
+
 func parseMethodMap(remaining string) (source, target, custom string, err error) {
 	parts := strings.SplitN(remaining, "|", 2)
 	if len(parts) == 2 {
@@ -207,8 +330,5 @@ func parseMethodMap(remaining string) (source, target, custom string, err error)
 	default:
 		err = fmt.Errorf("too many fields expected at most 2 fields got %d: %s", len(fields), remaining)
 	}
-	if err == nil && strings.ContainsRune(target, '.') {
-		err = fmt.Errorf("the mapping target %q must be a field name but was a path.\nDots \".\" are not allowed.", target)
-	}
 	return source, target, custom, err
 }
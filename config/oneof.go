@@ -0,0 +1,64 @@
+package config
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+)
+
+const configOneofTypes = "oneof:types"
+
+type Oneof struct {
+	Enabled bool
+	Unknown string
+	Map     []OneofMapping
+	// Types are implementations defined outside of the package of the
+	// interface.
+	Types []*types.Named
+}
+
+// OneofMapping maps an implementation of the source interface to an
+// implementation of the target interface.
+type OneofMapping struct {
+	Source string
+	Target string
+}
+
+func parseOneofMap(c *Common, rest string) error {
+	fields := strings.Fields(rest)
+	if len(fields) != 2 {
+		return fmt.Errorf("expected SOURCE TARGET but got %q", rest)
+	}
+
+	c.Oneof.Map = appendCopy(c.Oneof.Map, OneofMapping{Source: fields[0], Target: fields[1]})
+	return nil
+}
+
+func parseOneofTypes(ctx *context, c *Converter, o *Oneof, rest string) error {
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return fmt.Errorf("expected at least one type")
+	}
+
+	implementations := make([]*types.Named, 0, len(fields))
+	for _, field := range fields {
+		named, err := loadNamedType(ctx, c, field)
+		if err != nil {
+			return err
+		}
+		implementations = append(implementations, named)
+	}
+	o.Types = appendCopy(o.Types, implementations...)
+	return nil
+}
+
+// Target returns the target implementation configured via oneof:map for the
+// source implementation. Later definitions take precedence.
+func (o *Oneof) Target(source string) (string, bool) {
+	for i := len(o.Map) - 1; i >= 0; i-- {
+		if o.Map[i].Source == source {
+			return o.Map[i].Target, true
+		}
+	}
+	return "", false
+}
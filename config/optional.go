@@ -0,0 +1,23 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OptionalWrapper describes a struct wrapping an optional value, f.ex.
+// sql.NullString.
+type OptionalWrapper struct {
+	Value string
+	Valid string
+}
+
+func parseOptionalWrapper(c *Common, rest string) error {
+	fields := strings.Fields(rest)
+	if len(fields) != 2 {
+		return fmt.Errorf("expected VALUE VALID but got %q", rest)
+	}
+
+	c.OptionalWrappers = appendCopy(c.OptionalWrappers, OptionalWrapper{Value: fields[0], Valid: fields[1]})
+	return nil
+}
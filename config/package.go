@@ -31,7 +31,9 @@ func getPackages(raw *Raw) []string {
 		lookup[filepath.Join(c.PackagePath, "generated")] = struct{}{}
 
 		registerConverterLines(lookup, raw.WorkDir, c.FileName, c.PackagePath, c.Converter)
-		registerConverterLines(lookup, raw.WorkDir, c.FileName, c.PackagePath, raw.Global)
+		for _, lines := range raw.sharedLines(c.PackagePath) {
+			registerConverterLines(lookup, raw.WorkDir, c.FileName, c.PackagePath, lines.Lines)
+		}
 		for _, m := range c.Methods {
 			registerMethodLines(lookup, c.PackagePath, m)
 		}
@@ -53,6 +55,10 @@ func registerConverterLines(lookup map[string]struct{}, cwd, filename, sourcePac
 			for _, fullMethod := range strings.Fields(rest) {
 				registerFullMethod(lookup, sourcePackage, fullMethod)
 			}
+		case "enum:members", "enum:members:func", configOneofTypes:
+			for _, full := range strings.Fields(rest) {
+				registerFullMethod(lookup, sourcePackage, full)
+			}
 		case configOutputFile:
 			file, err := parse.File(cwd, rest)
 			if err != nil {
@@ -75,8 +81,20 @@ func registerMethodLines(lookup map[string]struct{}, sourcePackage string, lines
 			if _, _, custom, err := parseMethodMap(rest); err == nil && custom != "" {
 				registerFullMethod(lookup, sourcePackage, custom)
 			}
-		case configDefault:
+		case configDefault, configBefore, configAfter:
 			registerFullMethod(lookup, sourcePackage, rest)
+		case configOneofTypes:
+			for _, full := range strings.Fields(rest) {
+				registerFullMethod(lookup, sourcePackage, full)
+			}
+		case configMapIf:
+			if fields := strings.Fields(rest); len(fields) == 2 {
+				registerFullMethod(lookup, sourcePackage, fields[1])
+			}
+		case configValue:
+			if parts := strings.SplitN(strings.TrimSpace(rest), " ", 2); len(parts) == 2 && isConstantReference(strings.TrimSpace(parts[1])) {
+				registerFullMethod(lookup, sourcePackage, strings.TrimSpace(parts[1]))
+			}
 		}
 	}
 }
@@ -0,0 +1,207 @@
+package config
+
+import (
+	"fmt"
+	"go/types"
+	"sort"
+	"strings"
+
+	"github.com/jmattheis/goverter/xtype"
+)
+
+// reverse is the goverter:reverse setting of a method.
+type reverse struct {
+	Name  string
+	Lines RawLines
+	Value string
+}
+
+func applyReverse(c *Converter) error {
+	for _, m := range c.Methods {
+		if m.reverse == nil {
+			continue
+		}
+		if err := applyReverseMethod(c, m); err != nil {
+			return formatLineError(m.reverse.Lines, c.IDString(), m.OriginID, m.OriginID, m.reverse.Value, err)
+		}
+	}
+	return nil
+}
+
+func applyReverseMethod(c *Converter, forward *Method) error {
+	var rev *Method
+	for _, m := range c.Methods {
+		if m.Name == forward.reverse.Name {
+			rev = m
+		}
+	}
+	switch {
+	case rev == nil:
+		return fmt.Errorf("reverse method %q does not exist on %s", forward.reverse.Name, c.IDString())
+	case rev == forward:
+		return fmt.Errorf("method cannot be the reverse of itself")
+	case rev.reverse != nil:
+		return fmt.Errorf("%s cannot be used as reverse method, because it defines goverter:reverse itself", rev.Name)
+	case len(forward.MultiSources) > 0 || len(rev.MultiSources) > 0:
+		return fmt.Errorf("reverse is not supported for methods with multiple source params")
+	case !types.Identical(rev.Source.T, forward.Target.T) || !types.Identical(rev.Target.T, forward.Source.T):
+		return fmt.Errorf("reverse method %s must convert %s to %s but converts %s to %s",
+			rev.Name, forward.Target.String, forward.Source.String, rev.Source.String, rev.Target.String)
+	}
+
+	derived, autoMap, err := reverseFields(forward)
+	if err != nil {
+		return err
+	}
+
+	targets := make([]string, 0, len(derived))
+	for target := range derived {
+		targets = append(targets, target)
+	}
+	sort.Strings(targets)
+
+	for _, target := range targets {
+		if _, ok := rev.Fields[target]; ok {
+			// explicitly defined settings take precedence
+			continue
+		}
+		field := derived[target]
+		if field.Function != nil {
+			return missingInverseError(rev, target, field)
+		}
+		if field.Condition != nil {
+			return missingInverseConditionError(rev, target, field)
+		}
+		rev.Fields[target] = field
+		if field.Ignore {
+			rev.RawFieldSettings = append(rev.RawFieldSettings, "ignore "+target)
+		} else {
+			rev.RawFieldSettings = append(rev.RawFieldSettings, fmt.Sprintf("map %s %s", field.Source, target))
+		}
+	}
+	for _, path := range autoMap {
+		rev.AutoMap = append(rev.AutoMap, path)
+		rev.RawFieldSettings = append(rev.RawFieldSettings, "autoMap "+path)
+	}
+
+	return reverseEnumMapping(forward, rev)
+}
+
+// reverseFields swaps the source and target paths of the field settings.
+// Mappings with a function or condition must be defined explicitly on the
+// reverse method.
+func reverseFields(forward *Method) (map[string]*FieldMapping, []string, error) {
+	targets := make([]string, 0, len(forward.Fields))
+	for target := range forward.Fields {
+		targets = append(targets, target)
+	}
+	sort.Strings(targets)
+
+	derived := map[string]*FieldMapping{}
+	var autoMap []string
+	for _, target := range targets {
+		field := forward.Fields[target]
+		switch {
+		case field.Ignore:
+			// the field may be missing on the reverse target.
+			if hasFieldPath(forward.Source, target) {
+				derived[target] = &FieldMapping{Ignore: true}
+			}
+		case field.Source == "" || (field.Source == "." && field.Function != nil):
+			// there is no source field that could be set in the reverse method.
+		case field.Source == ".":
+			autoMap = append(autoMap, target)
+		default:
+			if existing, ok := derived[field.Source]; ok {
+				return nil, nil, fmt.Errorf("cannot reverse, because %q is mapped to %q and %q", field.Source, existing.Source, target)
+			}
+			derived[field.Source] = &FieldMapping{Source: target, Function: field.Function, Condition: field.Condition}
+		}
+	}
+
+	for _, path := range forward.AutoMap {
+		if _, ok := derived[path]; ok {
+			return nil, nil, fmt.Errorf("cannot reverse, because autoMap %q is also used as map source", path)
+		}
+		derived[path] = &FieldMapping{Source: "."}
+	}
+	return derived, autoMap, nil
+}
+
+// hasFieldPath returns true if the dot separated path exists on t.
+func hasFieldPath(t *xtype.Type, path string) bool {
+	for _, name := range strings.Split(path, ".") {
+		if t.Pointer {
+			t = t.PointerInner
+		}
+		if !t.Struct {
+			return false
+		}
+		field, err := xtype.FindExactField(t, name)
+		if err != nil {
+			return false
+		}
+		t = field.Type
+	}
+	return true
+}
+
+func reverseEnumMapping(forward, rev *Method) error {
+	if len(forward.EnumMapping.Transformers) > 0 {
+		return fmt.Errorf("enum:transform cannot be reversed, define the enum settings on %s instead", rev.Name)
+	}
+
+	keys := make([]string, 0, len(forward.EnumMapping.Map))
+	for key := range forward.EnumMapping.Map {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	reversed := map[string]string{}
+	for _, source := range keys {
+		target := forward.EnumMapping.Map[source]
+		if IsEnumAction(target) {
+			continue
+		}
+		if existing, ok := reversed[target]; ok {
+			return fmt.Errorf("cannot reverse, because enum:map maps %s and %s to %s", existing, source, target)
+		}
+		reversed[target] = source
+	}
+
+	for source, target := range reversed {
+		if _, ok := rev.EnumMapping.Map[source]; !ok {
+			rev.EnumMapping.Map[source] = target
+		}
+	}
+	return nil
+}
+
+func missingInverseError(rev *Method, target string, field *FieldMapping) error {
+	return fmt.Errorf(`the mapping "map %s %s | %s" has no declared inverse.
+
+Define the inverse mapping on %s, f.ex.:
+
+    goverter:map %s %s | %sInverse
+
+or ignore the field:
+
+    goverter:ignore %s`,
+		target, field.Source, field.Function.QualifiedName(), rev.Name,
+		field.Source, target, field.Function.Name, target)
+}
+
+func missingInverseConditionError(rev *Method, target string, field *FieldMapping) error {
+	return fmt.Errorf(`the mapping "map %s %s" with "map:if %s %s" has no declared inverse.
+
+Define the inverse mapping on %s, f.ex.:
+
+    goverter:map %s %s
+    goverter:map:if %s %sInverse
+
+or ignore the field:
+
+    goverter:ignore %s`,
+		target, field.Source, field.Source, field.Condition.QualifiedName(), rev.Name,
+		field.Source, target, target, field.Condition.Name, target)
+}
@@ -0,0 +1,177 @@
+package config
+
+import (
+	"fmt"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"math"
+	"strings"
+
+	"github.com/jmattheis/goverter/pkgload"
+	"github.com/jmattheis/goverter/xtype"
+)
+
+const configValue = "value"
+
+// FieldValue is a constant value of a target field defined with goverter:value.
+type FieldValue struct {
+	// Raw is the value as defined in the setting.
+	Raw string
+	// Literal is the Go expression of the value, if the value isn't a
+	// reference to a declared constant.
+	Literal string
+	// Package and Name reference a declared constant.
+	Package string
+	Name    string
+
+	typ   types.Type
+	value constant.Value
+}
+
+// parseValue parses the value of goverter:value. The value is either a
+// constant Go expression like 2 or "api", or a constant declared in a
+// package like time:Second.
+func parseValue(ctx *context, c *Converter, rest string) (string, *FieldValue, error) {
+	parts := strings.SplitN(strings.TrimSpace(rest), " ", 2)
+	if len(parts) != 2 || strings.TrimSpace(parts[1]) == "" {
+		return "", nil, fmt.Errorf("expected FIELD VALUE but got %q", rest)
+	}
+	target, raw := parts[0], strings.TrimSpace(parts[1])
+
+	if tv, err := types.Eval(token.NewFileSet(), nil, token.NoPos, raw); err == nil {
+		if tv.Value == nil && !tv.IsNil() {
+			return "", nil, fmt.Errorf("value %q must be a constant", raw)
+		}
+		return target, &FieldValue{Raw: raw, Literal: raw, typ: tv.Type, value: tv.Value}, nil
+	} else if !isConstantReference(raw) {
+		return "", nil, fmt.Errorf("invalid value %q: %s", raw, err)
+	}
+
+	pkg, name, err := pkgload.ParseMethodString(c.Package, raw)
+	if err != nil {
+		return "", nil, err
+	}
+	_, obj, err := ctx.Loader.GetOneRaw(pkg, name)
+	if err != nil {
+		return "", nil, err
+	}
+	constObj, ok := obj.(*types.Const)
+	if !ok {
+		return "", nil, fmt.Errorf("%s must be a constant", obj)
+	}
+	if !xtype.Accessible(constObj, c.OutputPackagePath) {
+		return "", nil, fmt.Errorf("%s must be exported", obj)
+	}
+	return target, &FieldValue{
+		Raw:     raw,
+		Package: constObj.Pkg().Path(),
+		Name:    constObj.Name(),
+		typ:     constObj.Type(),
+		value:   constObj.Val(),
+	}, nil
+}
+
+// isConstantReference returns true, if raw has the format [PACKAGE:]NAME.
+func isConstantReference(raw string) bool {
+	return !strings.ContainsAny(raw, "\"'` ")
+}
+
+// check returns an error, if the value cannot be assigned to the target type.
+func (v *FieldValue) check(target types.Type) error {
+	if v.value == nil {
+		switch target.Underlying().(type) {
+		case *types.Pointer, *types.Slice, *types.Map, *types.Chan, *types.Signature, *types.Interface:
+			return nil
+		default:
+			return fmt.Errorf("cannot use nil as %s value", target)
+		}
+	}
+
+	basic, untyped := v.typ.(*types.Basic)
+	untyped = untyped && basic.Info()&types.IsUntyped != 0
+	if !untyped {
+		if !types.AssignableTo(v.typ, target) {
+			return fmt.Errorf("cannot use %s (constant of type %s) as %s value", v.Raw, v.typ, target)
+		}
+		return nil
+	}
+
+	if _, ok := target.Underlying().(*types.Interface); ok {
+		if !types.AssignableTo(types.Default(v.typ), target) {
+			return fmt.Errorf("cannot use %s (%s constant) as %s value", v.Raw, v.typ, target)
+		}
+		return nil
+	}
+
+	targetBasic, ok := target.Underlying().(*types.Basic)
+	if !ok {
+		return fmt.Errorf("cannot use %s (%s constant) as %s value", v.Raw, v.typ, target)
+	}
+	if reason := representable(v.value, targetBasic); reason != "" {
+		return fmt.Errorf("cannot use %s (%s constant) as %s value: %s", v.Raw, v.typ, target, reason)
+	}
+	return nil
+}
+
+// valueSizes are the sizes of a 32 bit platform, int, uint and uintptr
+// constants must fit into them on every platform.
+var valueSizes = types.SizesFor("gc", "386")
+
+// representable returns the reason why the constant cannot be represented by
+// t or an empty string.
+func representable(value constant.Value, t *types.Basic) string {
+	info := t.Info()
+	switch {
+	case info&types.IsBoolean != 0:
+		if value.Kind() != constant.Bool {
+			return "mismatched types"
+		}
+	case info&types.IsString != 0:
+		if value.Kind() != constant.String {
+			return "mismatched types"
+		}
+	case info&types.IsInteger != 0:
+		if value.Kind() == constant.Bool || value.Kind() == constant.String {
+			return "mismatched types"
+		}
+		x := constant.ToInt(value)
+		if x.Kind() != constant.Int {
+			return "truncated"
+		}
+		bits := uint(valueSizes.Sizeof(t) * 8)
+		var min, max constant.Value
+		if info&types.IsUnsigned != 0 {
+			min = constant.MakeInt64(0)
+			max = constant.Shift(constant.MakeInt64(1), token.SHL, bits)
+		} else {
+			min = constant.UnaryOp(token.SUB, constant.Shift(constant.MakeInt64(1), token.SHL, bits-1), 0)
+			max = constant.Shift(constant.MakeInt64(1), token.SHL, bits-1)
+		}
+		if constant.Compare(x, token.LSS, min) || constant.Compare(x, token.GEQ, max) {
+			if k := t.Kind(); k == types.Int || k == types.Uint || k == types.Uintptr {
+				return fmt.Sprintf("overflows on 32 bit platforms where %s has %d bits", t.Name(), bits)
+			}
+			return "overflows"
+		}
+	case info&types.IsFloat != 0:
+		x := constant.ToFloat(value)
+		if x.Kind() != constant.Float && x.Kind() != constant.Int {
+			return "mismatched types"
+		}
+		if t.Kind() == types.Float32 {
+			if f, _ := constant.Float32Val(x); math.IsInf(float64(f), 0) {
+				return "overflows"
+			}
+		} else if f, _ := constant.Float64Val(x); math.IsInf(f, 0) {
+			return "overflows"
+		}
+	case info&types.IsComplex != 0:
+		if constant.ToComplex(value).Kind() != constant.Complex {
+			return "mismatched types"
+		}
+	default:
+		return "mismatched types"
+	}
+	return ""
+}
@@ -0,0 +1,55 @@
+// Package diagnostic provides machine-readable information about errors that
+// occurred while generating converters.
+package diagnostic
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// Error is an error with structured information about where it occurred.
+// Error() returns the human-readable message.
+type Error struct {
+	// Location is the file:line of the setting or method that caused the error.
+	Location string `json:"location,omitempty"`
+	// Converter is the ID of the converter, e.g. the interface type.
+	Converter string `json:"converter,omitempty"`
+	// Method is the ID of the conversion method.
+	Method string `json:"method,omitempty"`
+	// Path is the conversion path from the method source and target to the
+	// types that caused the error.
+	Path []Path `json:"path,omitempty"`
+	// Cause is the reason of the error.
+	Cause string `json:"cause"`
+
+	// Message is the human-readable error message.
+	Message string `json:"-"`
+}
+
+// Path is one step inside the conversion path of an Error.
+type Path struct {
+	SourceID   string `json:"sourceID,omitempty"`
+	SourceType string `json:"sourceType,omitempty"`
+	TargetID   string `json:"targetID,omitempty"`
+	TargetType string `json:"targetType,omitempty"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// From converts err to a diagnostic. Errors without structured information
+// only contain the cause.
+func From(err error) *Error {
+	var diag *Error
+	if errors.As(err, &diag) {
+		return diag
+	}
+	return &Error{Cause: err.Error(), Message: err.Error()}
+}
+
+// WriteJSON writes err as single line JSON record to w.
+func WriteJSON(w io.Writer, err error) error {
+	return json.NewEncoder(w).Encode(From(err))
+}
@@ -0,0 +1,164 @@
+// Package diff creates line based diffs in the unified format.
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+const context = 3
+
+// maxTable limits the size of the longest common subsequence table. Larger
+// changed regions are diffed as a whole, by removing all old and adding all new
+// lines.
+const maxTable = 1 << 22
+
+type op struct {
+	kind byte
+	// line includes the trailing newline, if there is one.
+	line string
+	// a and b are the zero based line indexes in the old and new content.
+	a, b int
+}
+
+// Unified returns the unified diff between oldContent and newContent. An empty string is
+// returned if the content is equal.
+func Unified(oldName, newName string, oldContent, newContent []byte) string {
+	if string(oldContent) == string(newContent) {
+		return ""
+	}
+
+	ops := edits(splitLines(oldContent), splitLines(newContent))
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", oldName, newName)
+	for start := 0; start < len(ops); {
+		if ops[start].kind == ' ' {
+			start++
+			continue
+		}
+		from := max(start-context, 0)
+		end := start
+		for i := start; i < len(ops) && i <= end+2*context; i++ {
+			if ops[i].kind != ' ' {
+				end = i
+			}
+		}
+		to := min(end+context+1, len(ops))
+		writeHunk(&sb, ops[from:to])
+		start = to
+	}
+	return sb.String()
+}
+
+func writeHunk(sb *strings.Builder, ops []op) {
+	aStart, bStart := ops[0].a, ops[0].b
+	aLen, bLen := 0, 0
+	for _, o := range ops {
+		if o.kind != '+' {
+			aLen++
+		}
+		if o.kind != '-' {
+			bLen++
+		}
+	}
+	fmt.Fprintf(sb, "@@ -%s +%s @@\n", hunkRange(aStart, aLen), hunkRange(bStart, bLen))
+	for _, o := range ops {
+		sb.WriteByte(o.kind)
+		sb.WriteString(o.line)
+		if !strings.HasSuffix(o.line, "\n") {
+			sb.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+func hunkRange(start, length int) string {
+	if length == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	return fmt.Sprintf("%d,%d", start+1, length)
+}
+
+// splitLines keeps the newline of each line, so that a last line without a
+// newline differs from the same line with one.
+func splitLines(content []byte) []string {
+	if len(content) == 0 {
+		return nil
+	}
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// edits computes the edit script via the longest common subsequence of
+// both line lists. The common prefix and suffix are skipped, so that the
+// quadratic part only covers the changed region. Changed regions that exceed
+// maxTable are replaced as a whole.
+func edits(a, b []string) []op {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := []op{}
+	for i := 0; i < prefix; i++ {
+		ops = append(ops, op{kind: ' ', line: a[i], a: i, b: i})
+	}
+
+	ma, mb := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	if (len(ma)+1)*(len(mb)+1) > maxTable {
+		for i, line := range ma {
+			ops = append(ops, op{kind: '-', line: line, a: prefix + i, b: prefix})
+		}
+		for j, line := range mb {
+			ops = append(ops, op{kind: '+', line: line, a: prefix + len(ma), b: prefix + j})
+		}
+		return appendSuffix(ops, a, b, suffix)
+	}
+
+	lcs := make([][]int, len(ma)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(mb)+1)
+	}
+	for i := len(ma) - 1; i >= 0; i-- {
+		for j := len(mb) - 1; j >= 0; j-- {
+			if ma[i] == mb[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(ma) || j < len(mb) {
+		switch {
+		case i < len(ma) && j < len(mb) && ma[i] == mb[j]:
+			ops = append(ops, op{kind: ' ', line: ma[i], a: prefix + i, b: prefix + j})
+			i++
+			j++
+		case i < len(ma) && (j == len(mb) || lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, op{kind: '-', line: ma[i], a: prefix + i, b: prefix + j})
+			i++
+		default:
+			ops = append(ops, op{kind: '+', line: mb[j], a: prefix + i, b: prefix + j})
+			j++
+		}
+	}
+
+	return appendSuffix(ops, a, b, suffix)
+}
+
+func appendSuffix(ops []op, a, b []string, suffix int) []op {
+	for k := 0; k < suffix; k++ {
+		ai, bi := len(a)-suffix+k, len(b)-suffix+k
+		ops = append(ops, op{kind: ' ', line: a[ai], a: ai, b: bi})
+	}
+	return ops
+}
@@ -0,0 +1,78 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnified(t *testing.T) {
+	actual := Unified("a.go", "b.go", []byte("a\nb\nc\nd\n"), []byte("a\nc\nd\ne\n"))
+	require.Equal(t, `--- a.go
++++ b.go
+@@ -1,4 +1,4 @@
+ a
+-b
+ c
+ d
++e
+`, actual)
+}
+
+func TestUnifiedEqual(t *testing.T) {
+	require.Equal(t, "", Unified("a.go", "b.go", []byte("a\n"), []byte("a\n")))
+}
+
+func TestUnifiedMissingNewline(t *testing.T) {
+	actual := Unified("a.go", "b.go", []byte("a\nb\n"), []byte("a\nb"))
+	require.Equal(t, `--- a.go
++++ b.go
+@@ -1,2 +1,2 @@
+ a
+-b
++b
+\ No newline at end of file
+`, actual)
+
+	actual = Unified("a.go", "b.go", []byte("a\nb"), []byte("a\nc"))
+	require.Equal(t, `--- a.go
++++ b.go
+@@ -1,2 +1,2 @@
+ a
+-b
+\ No newline at end of file
++c
+\ No newline at end of file
+`, actual)
+}
+
+func TestUnifiedExceedsMaxTable(t *testing.T) {
+	size := 2100
+	require.Greater(t, (size+1)*(size+1), maxTable)
+
+	oldLines := []string{"start"}
+	newLines := []string{"start"}
+	for i := 0; i < size; i++ {
+		oldLines = append(oldLines, fmt.Sprintf("old %d", i))
+		newLines = append(newLines, fmt.Sprintf("new %d", i))
+	}
+	oldLines = append(oldLines, "end")
+	newLines = append(newLines, "end")
+
+	actual := Unified("a.go", "b.go",
+		[]byte(strings.Join(oldLines, "\n")+"\n"),
+		[]byte(strings.Join(newLines, "\n")+"\n"))
+
+	var expected strings.Builder
+	fmt.Fprintf(&expected, "--- a.go\n+++ b.go\n@@ -1,%d +1,%d @@\n start\n", size+2, size+2)
+	for i := 0; i < size; i++ {
+		fmt.Fprintf(&expected, "-old %d\n", i)
+	}
+	for i := 0; i < size; i++ {
+		fmt.Fprintf(&expected, "+new %d\n", i)
+	}
+	expected.WriteString(" end\n")
+	require.Equal(t, expected.String(), actual)
+}
@@ -101,11 +101,14 @@ export default defineConfig({
                 collapsed: true,
                 items: [
                   { text: "autoMap", link: "/reference/autoMap" },
+                  { text: "before, after", link: "/reference/hooks" },
                   { text: "context", link: "/reference/context" },
                   { text: "default", link: "/reference/default" },
                   { text: "ignore", link: "/reference/ignore" },
                   { text: "map", link: "/reference/map" },
+                  { text: "reverse", link: "/reference/reverse" },
                   { text: "update", link: "/reference/update" },
+                  { text: "value", link: "/reference/value" },
                 ],
               },
               {
@@ -114,15 +117,23 @@ export default defineConfig({
                 items: [
                   { text: "annotate", link: "/reference/annotate" },
                   { text: "arg", link: "/reference/arg" },
+                  { text: "convert", link: "/reference/convert" },
+                  { text: "errors", link: "/reference/errors" },
                   { text: "ignoreMissing", link: "/reference/ignoreMissing" },
                   {
                     text: "ignoreUnexported",
                     link: "/reference/ignoreUnexported",
                   },
+                  { text: "list", link: "/reference/list" },
                   {
                     text: "matchIgnoreCase",
                     link: "/reference/matchIgnoreCase",
                   },
+                  { text: "match", link: "/reference/match" },
+                  { text: "numeric", link: "/reference/numeric" },
+                  { text: "oneof", link: "/reference/oneof" },
+                  { text: "optional", link: "/reference/optional" },
+                  { text: "proto", link: "/reference/proto" },
                   {
                     text: "skipCopySameType",
                     link: "/reference/skipCopySameType",
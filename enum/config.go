@@ -1,11 +1,31 @@
 package enum
 
-import "regexp"
+import (
+	"go/types"
+	"regexp"
+)
 
 type Config struct {
-	Unknown  string
-	Enabled  bool
-	Excludes IDPatterns
+	Unknown    string
+	Enabled    bool
+	Excludes   IDPatterns
+	ToString   bool
+	FromString bool
+	Exhaustive bool
+	Flags      bool
+	// Declared contains the enums declared with enum:members and
+	// enum:members:func.
+	Declared []Enum
+}
+
+// Lookup returns the declared enum of the type.
+func (c *Config) Lookup(t *types.Named) (Enum, bool) {
+	for _, e := range c.Declared {
+		if types.Identical(e.Type, t) {
+			return e, true
+		}
+	}
+	return Enum{}, false
 }
 
 type IDPattern struct {
@@ -5,4 +5,20 @@ import "go/types"
 type Enum struct {
 	Type    *types.Named
 	Members map[string]any
+	// Packages contains the package paths of members that aren't declared in
+	// the package of Type. It's only set for enums declared with
+	// enum:members or enum:members:func.
+	Packages map[string]string
 }
+
+// Package returns the path of the package where the member is declared.
+func (e Enum) Package(member string) string {
+	if pkg, ok := e.Packages[member]; ok {
+		return pkg
+	}
+	return e.Type.Obj().Pkg().Path()
+}
+
+// Variable is the value of members declared as variables, their value is
+// unknown at generation time.
+type Variable string
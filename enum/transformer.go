@@ -7,6 +7,10 @@ package enum
 // mapped by the transformer just skip the key and don't return it. An error by
 // this methods aborts the aborts the whole goverter conversion, so only use it
 // when there are config errors.
+//
+// For conversions between enums and strings (enum:toString, enum:fromString)
+// the string side has no members, context.Target.Members is nil and the
+// transformer may return any string as value.
 type Transformer func(context TransformContext) (map[string]string, error)
 
 type TransformContext struct {
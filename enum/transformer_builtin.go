@@ -7,7 +7,14 @@ import (
 )
 
 var DefaultTransformers = map[string]Transformer{
-	"regex": transformRegex,
+	"regex":      transformRegex,
+	"trimPrefix": transformName("trimPrefix"),
+	"addPrefix":  transformName("addPrefix"),
+	"trimSuffix": transformName("trimSuffix"),
+	"snakeUpper": transformName("snakeUpper"),
+	"camel":      transformName("camel"),
+	"lower":      transformName("lower"),
+	"upper":      transformName("upper"),
 }
 
 func transformRegex(ctx TransformContext) (map[string]string, error) {
@@ -24,7 +31,7 @@ func transformRegex(ctx TransformContext) (map[string]string, error) {
 	m := map[string]string{}
 	for key := range ctx.Source.Members {
 		targetKey := pattern.ReplaceAllString(key, parts[1])
-		if _, ok := ctx.Target.Members[targetKey]; ok {
+		if _, ok := ctx.Target.Members[targetKey]; ok || ctx.Target.Members == nil {
 			m[key] = targetKey
 		}
 	}
@@ -0,0 +1,105 @@
+package enum
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jmattheis/goverter/strcase"
+)
+
+// nameTransformers transform a single member name. They can be chained with
+// "|" in one enum:transform setting, f.ex. "trimPrefix Color|snakeUpper".
+var nameTransformers = map[string]func(config string) (func(string) string, error){
+	"trimPrefix": func(config string) (func(string) string, error) {
+		if config == "" {
+			return nil, fmt.Errorf("invalid config, trimPrefix requires a prefix")
+		}
+		return func(name string) string { return strings.TrimPrefix(name, config) }, nil
+	},
+	"addPrefix": func(config string) (func(string) string, error) {
+		if config == "" {
+			return nil, fmt.Errorf("invalid config, addPrefix requires a prefix")
+		}
+		return func(name string) string { return config + name }, nil
+	},
+	"trimSuffix": func(config string) (func(string) string, error) {
+		if config == "" {
+			return nil, fmt.Errorf("invalid config, trimSuffix requires a suffix")
+		}
+		return func(name string) string { return strings.TrimSuffix(name, config) }, nil
+	},
+	"snakeUpper": noConfig("snakeUpper", strcase.ScreamingSnake),
+	"camel":      noConfig("camel", strcase.Camel),
+	"lower":      noConfig("lower", strings.ToLower),
+	"upper":      noConfig("upper", strings.ToUpper),
+}
+
+func noConfig(name string, fn func(string) string) func(config string) (func(string) string, error) {
+	return func(config string) (func(string) string, error) {
+		if config != "" {
+			return nil, fmt.Errorf("invalid config, %s has no config", name)
+		}
+		return fn, nil
+	}
+}
+
+// transformName creates a Transformer for the name transformer with the given
+// name. The config may contain further name transformers separated by "|".
+func transformName(name string) Transformer {
+	return func(ctx TransformContext) (map[string]string, error) {
+		fn, err := parseNameChain(name + " " + ctx.Config)
+		if err != nil {
+			return nil, err
+		}
+
+		keys := make([]string, 0, len(ctx.Source.Members))
+		for key := range ctx.Source.Members {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		m := map[string]string{}
+		sources := map[string]string{}
+		for _, key := range keys {
+			targetKey := fn(key)
+			if _, ok := ctx.Target.Members[targetKey]; !ok && ctx.Target.Members != nil {
+				continue
+			}
+			if previous, ok := sources[targetKey]; ok {
+				return nil, fmt.Errorf("%s and %s both transform to %s", previous, key, targetKey)
+			}
+			sources[targetKey] = key
+			m[key] = targetKey
+		}
+		return m, nil
+	}
+}
+
+func parseNameChain(chain string) (func(string) string, error) {
+	var fns []func(string) string
+	for _, step := range strings.Split(chain, "|") {
+		parts := strings.SplitN(strings.TrimSpace(step), " ", 2)
+		config := ""
+		if len(parts) == 2 {
+			config = strings.TrimSpace(parts[1])
+		}
+
+		create, ok := nameTransformers[parts[0]]
+		if !ok {
+			return nil, fmt.Errorf("transformer %q cannot be chained", parts[0])
+		}
+		fn, err := create(config)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", parts[0], err)
+		}
+		fns = append(fns, fn)
+	}
+
+	return func(name string) string {
+		for _, fn := range fns {
+			name = fn(name)
+		}
+		return name
+	}, nil
+}
@@ -0,0 +1,40 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import (
+	convertstrconv "github.com/jmattheis/goverter/example/convert-strconv"
+	"strconv"
+)
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) ToDTO(source convertstrconv.Product) convertstrconv.ProductDTO {
+	var exampleProductDTO convertstrconv.ProductDTO
+	exampleProductDTO.ID = strconv.Itoa(source.ID)
+	exampleProductDTO.Price = strconv.FormatFloat(source.Price, 'g', -1, 64)
+	exampleProductDTO.Available = strconv.FormatBool(source.Available)
+	exampleProductDTO.Data = string(source.Data)
+	return exampleProductDTO
+}
+func (c *ConverterImpl) ToProduct(source convertstrconv.ProductDTO) (convertstrconv.Product, error) {
+	var exampleProduct convertstrconv.Product
+	xint, err := strconv.Atoi(source.ID)
+	if err != nil {
+		return exampleProduct, err
+	}
+	exampleProduct.ID = xint
+	xfloat64, err := strconv.ParseFloat(source.Price, 64)
+	if err != nil {
+		return exampleProduct, err
+	}
+	exampleProduct.Price = xfloat64
+	xbool, err := strconv.ParseBool(source.Available)
+	if err != nil {
+		return exampleProduct, err
+	}
+	exampleProduct.Available = xbool
+	exampleProduct.Data = []byte(source.Data)
+	return exampleProduct, nil
+}
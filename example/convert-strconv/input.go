@@ -0,0 +1,21 @@
+package example
+
+// goverter:converter
+// goverter:convert:strconv
+type Converter interface {
+	ToDTO(Product) ProductDTO
+	ToProduct(ProductDTO) (Product, error)
+}
+
+type Product struct {
+	ID        int
+	Price     float64
+	Available bool
+	Data      []byte
+}
+type ProductDTO struct {
+	ID        string
+	Price     string
+	Available string
+	Data      string
+}
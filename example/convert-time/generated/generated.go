@@ -0,0 +1,54 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import (
+	converttime "github.com/jmattheis/goverter/example/convert-time"
+	"time"
+)
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) ToDTO(source converttime.Job) converttime.JobDTO {
+	var exampleJobDTO converttime.JobDTO
+	exampleJobDTO.CreatedAt = c.timeTimeToString(source.CreatedAt)
+	exampleJobDTO.FinishedAt = c.pTimeTimeToPString(source.FinishedAt)
+	exampleJobDTO.Timeout = int(source.Timeout / time.Second)
+	return exampleJobDTO
+}
+func (c *ConverterImpl) ToJob(source converttime.JobDTO) (converttime.Job, error) {
+	var exampleJob converttime.Job
+	timeTime, err := c.stringToTimeTime(source.CreatedAt)
+	if err != nil {
+		return exampleJob, err
+	}
+	exampleJob.CreatedAt = timeTime
+	if source.FinishedAt != nil {
+		timeTime2, err := c.stringToTimeTime(*source.FinishedAt)
+		if err != nil {
+			return exampleJob, err
+		}
+		exampleJob.FinishedAt = &timeTime2
+	}
+	exampleJob.Timeout = time.Duration(source.Timeout) * time.Second
+	return exampleJob, nil
+}
+func (c *ConverterImpl) pTimeTimeToPString(source *time.Time) *string {
+	var pString *string
+	if source != nil {
+		xstring := c.timeTimeToString((*source))
+		pString = &xstring
+	}
+	return pString
+}
+func (c *ConverterImpl) stringToTimeTime(source string) (time.Time, error) {
+	timeTime, err := time.Parse(time.RFC3339, source)
+	if err != nil {
+		return (time.Time{}), err
+	}
+	return timeTime, nil
+}
+func (c *ConverterImpl) timeTimeToString(source time.Time) string {
+	return source.Format(time.RFC3339)
+}
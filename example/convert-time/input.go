@@ -0,0 +1,22 @@
+package example
+
+import "time"
+
+// goverter:converter
+// goverter:convert:time rfc3339
+// goverter:convert:time:duration s
+type Converter interface {
+	ToDTO(Job) JobDTO
+	ToJob(JobDTO) (Job, error)
+}
+
+type Job struct {
+	CreatedAt  time.Time
+	FinishedAt *time.Time
+	Timeout    time.Duration
+}
+type JobDTO struct {
+	CreatedAt  string
+	FinishedAt *string
+	Timeout    int
+}
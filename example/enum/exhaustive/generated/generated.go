@@ -0,0 +1,24 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import (
+	"fmt"
+	exhaustive "github.com/jmattheis/goverter/example/enum/exhaustive"
+)
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) Convert(source exhaustive.InputColor) (exhaustive.OutputColor, error) {
+	var exampleOutputColor exhaustive.OutputColor
+	switch source {
+	case exhaustive.InGreen:
+		exampleOutputColor = exhaustive.Green
+	case exhaustive.InGrey:
+		exampleOutputColor = exhaustive.Gray
+	default:
+		return exampleOutputColor, fmt.Errorf("unexpected enum element: %v", source)
+	}
+	return exampleOutputColor, nil
+}
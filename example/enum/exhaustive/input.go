@@ -0,0 +1,24 @@
+package example
+
+// goverter:converter
+// goverter:enum:exhaustive
+// goverter:enum:unknown @error
+type Converter interface {
+	// goverter:enum:transform trimPrefix In
+	// goverter:enum:map InGrey Gray
+	Convert(InputColor) (OutputColor, error)
+}
+
+type InputColor int
+
+const (
+	InGreen InputColor = iota
+	InGrey
+)
+
+type OutputColor string
+
+const (
+	Green OutputColor = "green"
+	Gray  OutputColor = "gray"
+)
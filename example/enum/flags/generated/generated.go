@@ -0,0 +1,28 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import (
+	"fmt"
+	flags "github.com/jmattheis/goverter/example/enum/flags"
+)
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) Convert(source flags.Perm) (flags.Permission, error) {
+	var examplePermission flags.Permission
+	if source&flags.PermExec != 0 {
+		examplePermission |= flags.Execute
+	}
+	if source&flags.PermRead != 0 {
+		examplePermission |= flags.Read
+	}
+	if source&flags.PermWrite != 0 {
+		examplePermission |= flags.Write
+	}
+	if source&^(flags.PermExec|flags.PermRead|flags.PermWrite) != 0 {
+		return examplePermission, fmt.Errorf("unexpected enum element: %v", source)
+	}
+	return examplePermission, nil
+}
@@ -0,0 +1,26 @@
+package example
+
+// goverter:converter
+// goverter:enum:flags
+// goverter:enum:unknown @error
+type Converter interface {
+	// goverter:enum:transform trimPrefix Perm
+	// goverter:enum:map PermExec Execute
+	Convert(Perm) (Permission, error)
+}
+
+type Perm uint8
+
+const (
+	PermRead Perm = 1 << iota
+	PermWrite
+	PermExec
+)
+
+type Permission int
+
+const (
+	Read Permission = 1 << iota
+	Write
+	Execute
+)
@@ -0,0 +1,38 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import (
+	"fmt"
+	members "github.com/jmattheis/goverter/example/enum/members"
+	api "github.com/jmattheis/goverter/example/enum/members/api"
+	status "github.com/jmattheis/goverter/example/enum/members/status"
+)
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) ConvertLevel(source members.Level) (members.OutputLevel, error) {
+	var exampleOutputLevel members.OutputLevel
+	switch source {
+	case members.High:
+		exampleOutputLevel = members.OutputHigh
+	case members.Low:
+		exampleOutputLevel = members.OutputLow
+	default:
+		return exampleOutputLevel, fmt.Errorf("unexpected enum element: %v", source)
+	}
+	return exampleOutputLevel, nil
+}
+func (c *ConverterImpl) ConvertStatus(source api.Status) (members.Status, error) {
+	var exampleStatus members.Status
+	switch source {
+	case status.Active:
+		exampleStatus = members.Active
+	case status.Inactive:
+		exampleStatus = members.Inactive
+	default:
+		return exampleStatus, fmt.Errorf("unexpected enum element: %v", source)
+	}
+	return exampleStatus, nil
+}
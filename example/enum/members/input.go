@@ -0,0 +1,39 @@
+package example
+
+import "github.com/jmattheis/goverter/example/enum/members/api"
+
+// goverter:converter
+// goverter:enum:members github.com/jmattheis/goverter/example/enum/members/api:Status github.com/jmattheis/goverter/example/enum/members/status:.*
+// goverter:enum:members:func Level LevelValues
+// goverter:enum:unknown @error
+type Converter interface {
+	ConvertStatus(api.Status) (Status, error)
+	// goverter:enum:transform addPrefix Output
+	ConvertLevel(Level) (OutputLevel, error)
+}
+
+type Status int
+
+const (
+	Active Status = iota
+	Inactive
+)
+
+type Level int
+
+const (
+	Low Level = iota
+	High
+	levelCount
+)
+
+func LevelValues() []Level {
+	return []Level{Low, High}
+}
+
+type OutputLevel string
+
+const (
+	OutputLow  OutputLevel = "low"
+	OutputHigh OutputLevel = "high"
+)
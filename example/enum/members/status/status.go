@@ -0,0 +1,8 @@
+package status
+
+import "github.com/jmattheis/goverter/example/enum/members/api"
+
+const (
+	Active   api.Status = "active"
+	Inactive api.Status = "inactive"
+)
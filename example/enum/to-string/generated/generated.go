@@ -0,0 +1,42 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import (
+	"fmt"
+	tostring "github.com/jmattheis/goverter/example/enum/to-string"
+)
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) FromString(source string) (tostring.Color, error) {
+	var exampleColor tostring.Color
+	switch source {
+	case "Blue":
+		exampleColor = tostring.ColorBlue
+	case "Gray":
+		exampleColor = tostring.ColorGray
+	case "Green":
+		exampleColor = tostring.ColorGreen
+	case "Grey":
+		exampleColor = tostring.ColorGray
+	default:
+		return exampleColor, fmt.Errorf("unexpected enum element: %v", source)
+	}
+	return exampleColor, nil
+}
+func (c *ConverterImpl) ToString(source tostring.Color) (string, error) {
+	var xstring string
+	switch source {
+	case tostring.ColorBlue:
+		xstring = "Blue"
+	case tostring.ColorGray:
+		xstring = "Gray"
+	case tostring.ColorGreen:
+		xstring = "Green"
+	default:
+		return xstring, fmt.Errorf("unexpected enum element: %v", source)
+	}
+	return xstring, nil
+}
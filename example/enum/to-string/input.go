@@ -0,0 +1,21 @@
+package example
+
+// goverter:converter
+// goverter:enum:toString
+// goverter:enum:fromString
+// goverter:enum:unknown @error
+type Converter interface {
+	// goverter:enum:transform regex Color(\w+) $1
+	ToString(Color) (string, error)
+	// goverter:enum:transform regex Color(\w+) $1
+	// goverter:enum:map Grey ColorGray
+	FromString(string) (Color, error)
+}
+
+type Color int
+
+const (
+	ColorGreen Color = iota
+	ColorBlue
+	ColorGray
+)
@@ -0,0 +1,40 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import (
+	"fmt"
+	transformchain "github.com/jmattheis/goverter/example/enum/transform-chain"
+)
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) Convert(source transformchain.Color) transformchain.APIColor {
+	var exampleAPIColor transformchain.APIColor
+	switch source {
+	case transformchain.ColorDarkBlue:
+		exampleAPIColor = transformchain.DARK_BLUE
+	case transformchain.ColorGreen:
+		exampleAPIColor = transformchain.GREEN
+	case transformchain.ColorRed:
+		exampleAPIColor = transformchain.RED
+	default:
+		panic(fmt.Sprintf("unexpected enum element: %v", source))
+	}
+	return exampleAPIColor
+}
+func (c *ConverterImpl) Convert2(source transformchain.APIColor) transformchain.Color {
+	var exampleColor transformchain.Color
+	switch source {
+	case transformchain.DARK_BLUE:
+		exampleColor = transformchain.ColorDarkBlue
+	case transformchain.GREEN:
+		exampleColor = transformchain.ColorGreen
+	case transformchain.RED:
+		exampleColor = transformchain.ColorRed
+	default:
+		panic(fmt.Sprintf("unexpected enum element: %v", source))
+	}
+	return exampleColor
+}
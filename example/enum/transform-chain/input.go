@@ -0,0 +1,26 @@
+package example
+
+// goverter:converter
+// goverter:enum:unknown @panic
+type Converter interface {
+	// goverter:enum:transform trimPrefix Color|snakeUpper
+	Convert(Color) APIColor
+	// goverter:enum:transform camel|addPrefix Color
+	Convert2(APIColor) Color
+}
+
+type Color int
+
+const (
+	ColorGreen Color = iota
+	ColorDarkBlue
+	ColorRed
+)
+
+type APIColor string
+
+const (
+	GREEN     APIColor = "green"
+	DARK_BLUE APIColor = "dark-blue"
+	RED       APIColor = "red"
+)
@@ -0,0 +1,39 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import (
+	"errors"
+	"fmt"
+	errorscollect "github.com/jmattheis/goverter/example/errors-collect"
+	"strconv"
+)
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) Convert(source errorscollect.Input) (errorscollect.Output, error) {
+	var errs []error
+	var exampleOutput errorscollect.Output
+	xint, err := strconv.Atoi(source.Age)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error setting field Age: %w", err))
+	}
+	exampleOutput.Age = xint
+	xint2, err := strconv.Atoi(source.Height)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error setting field Height: %w", err))
+	}
+	exampleOutput.Height = xint2
+	if source.Friends != nil {
+		exampleOutput.Friends = make([]int, len(source.Friends))
+		for i := 0; i < len(source.Friends); i++ {
+			xint3, err := strconv.Atoi(source.Friends[i])
+			if err != nil {
+				errs = append(errs, fmt.Errorf("error setting field Friends[%d]: %w", i, err))
+			}
+			exampleOutput.Friends[i] = xint3
+		}
+	}
+	return exampleOutput, errors.Join(errs...)
+}
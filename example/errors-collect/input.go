@@ -0,0 +1,20 @@
+package example
+
+// goverter:converter
+// goverter:extend strconv:Atoi
+// goverter:errors:collect
+type Converter interface {
+	Convert(Input) (Output, error)
+}
+
+type Input struct {
+	Age     string
+	Height  string
+	Friends []string
+}
+
+type Output struct {
+	Age     int
+	Height  int
+	Friends []int
+}
@@ -0,0 +1,34 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import hooks "github.com/jmattheis/goverter/example/hooks"
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) Convert(source hooks.Input) (hooks.Output, error) {
+	var exampleOutput hooks.Output
+	hooks.Normalize(source, &exampleOutput)
+	exampleOutput.FirstName = source.FirstName
+	exampleOutput.LastName = source.LastName
+	exampleOutput.Age = source.Age
+	if err := hooks.Validate(source, &exampleOutput); err != nil {
+		return exampleOutput, err
+	}
+	return exampleOutput, nil
+}
+func (c *ConverterImpl) ConvertAll(source []hooks.Input) ([]hooks.Output, error) {
+	var exampleOutputList []hooks.Output
+	if source != nil {
+		exampleOutputList = make([]hooks.Output, len(source))
+		for i := 0; i < len(source); i++ {
+			exampleOutput, err := c.Convert(source[i])
+			if err != nil {
+				return nil, err
+			}
+			exampleOutputList[i] = exampleOutput
+		}
+	}
+	return exampleOutputList, nil
+}
@@ -0,0 +1,42 @@
+package example
+
+import (
+	"fmt"
+	"strings"
+)
+
+// goverter:converter
+type Converter interface {
+	// goverter:before Normalize
+	// goverter:after Validate
+	// goverter:ignore FullName
+	Convert(Input) (Output, error)
+	ConvertAll([]Input) ([]Output, error)
+}
+
+func Normalize(source Input, target *Output) {
+	target.FullName = "unknown"
+}
+
+func Validate(source Input, target *Output) error {
+	if source.FirstName != "" || source.LastName != "" {
+		target.FullName = strings.TrimSpace(source.FirstName + " " + source.LastName)
+	}
+	if target.Age < 0 {
+		return fmt.Errorf("invalid age %d", target.Age)
+	}
+	return nil
+}
+
+type Input struct {
+	FirstName string
+	LastName  string
+	Age       int
+}
+
+type Output struct {
+	FirstName string
+	LastName  string
+	FullName  string
+	Age       int
+}
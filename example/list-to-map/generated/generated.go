@@ -0,0 +1,60 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import (
+	"fmt"
+	listtomap "github.com/jmattheis/goverter/example/list-to-map"
+	"sort"
+)
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) ToIndex(source []listtomap.User) (map[int]listtomap.UserDTO, error) {
+	var mapIntExampleUserDTO map[int]listtomap.UserDTO
+	if source != nil {
+		mapIntExampleUserDTO = make(map[int]listtomap.UserDTO, len(source))
+		for i := 0; i < len(source); i++ {
+			xint := source[i].ID
+			if _, ok := mapIntExampleUserDTO[xint]; ok {
+				return nil, fmt.Errorf("duplicate map key: %v", xint)
+			}
+			mapIntExampleUserDTO[xint] = c.exampleUserToExampleUserDTO(source[i])
+		}
+	}
+	return mapIntExampleUserDTO, nil
+}
+func (c *ConverterImpl) ToList(source map[int]listtomap.UserDTO) []listtomap.User {
+	var exampleUserList []listtomap.User
+	if source != nil {
+		keys := make([]int, 0, len(source))
+		for key := range source {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i] < keys[j]
+		})
+		exampleUserList = make([]listtomap.User, 0, len(source))
+		for _, key := range keys {
+			value := source[key]
+			exampleUserList = append(exampleUserList, c.exampleUserDTOToExampleUser(value))
+		}
+		sort.SliceStable(exampleUserList, func(i, j int) bool {
+			return exampleUserList[i].ID < exampleUserList[j].ID
+		})
+	}
+	return exampleUserList
+}
+func (c *ConverterImpl) exampleUserDTOToExampleUser(source listtomap.UserDTO) listtomap.User {
+	var exampleUser listtomap.User
+	exampleUser.ID = source.ID
+	exampleUser.Name = source.Name
+	return exampleUser
+}
+func (c *ConverterImpl) exampleUserToExampleUserDTO(source listtomap.User) listtomap.UserDTO {
+	var exampleUserDTO listtomap.UserDTO
+	exampleUserDTO.ID = source.ID
+	exampleUserDTO.Name = source.Name
+	return exampleUserDTO
+}
@@ -0,0 +1,19 @@
+package example
+
+// goverter:converter
+type Converter interface {
+	// goverter:list:toMap ID @error
+	ToIndex([]User) (map[int]UserDTO, error)
+	// goverter:map:toSlice ID
+	ToList(map[int]UserDTO) []User
+}
+
+type User struct {
+	ID   int
+	Name string
+}
+
+type UserDTO struct {
+	ID   int
+	Name string
+}
@@ -0,0 +1,20 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import mapif "github.com/jmattheis/goverter/example/map-if"
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) Convert(source mapif.Input) mapif.Output {
+	var exampleOutput mapif.Output
+	exampleOutput.Name = source.Name
+	if mapif.HasEmail(source) {
+		exampleOutput.Email = source.Email
+	}
+	if mapif.IsPublic(source) {
+		exampleOutput.Nickname = source.Nickname
+	}
+	return exampleOutput
+}
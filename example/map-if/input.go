@@ -0,0 +1,29 @@
+package example
+
+// goverter:converter
+type Converter interface {
+	// goverter:map:if Email HasEmail
+	// goverter:map:if Nickname IsPublic
+	Convert(Input) Output
+}
+
+func HasEmail(source Input) bool {
+	return source.Email != ""
+}
+
+func IsPublic(source Input) bool {
+	return source.Public
+}
+
+type Input struct {
+	Name     string
+	Email    string
+	Nickname string
+	Public   bool
+}
+
+type Output struct {
+	Name     string
+	Email    string
+	Nickname string
+}
@@ -0,0 +1,16 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import matchtag "github.com/jmattheis/goverter/example/match-tag"
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) Convert(source matchtag.UserModel) matchtag.UserDTO {
+	var exampleUserDTO matchtag.UserDTO
+	exampleUserDTO.ID = source.ID
+	exampleUserDTO.Name = source.UserName
+	exampleUserDTO.Email = source.Mail
+	return exampleUserDTO
+}
@@ -0,0 +1,18 @@
+package example
+
+// goverter:converter
+type Converter interface {
+	// goverter:match:tag json
+	Convert(UserModel) UserDTO
+}
+
+type UserModel struct {
+	ID       int    `json:"id"`
+	UserName string `json:"name"`
+	Mail     string `json:"email,omitempty"`
+}
+type UserDTO struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
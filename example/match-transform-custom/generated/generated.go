@@ -0,0 +1,15 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import example "goverter/example"
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) Convert(source example.Row) example.User {
+	var exampleUser example.User
+	exampleUser.Name = source.ColName
+	exampleUser.Email = source.ColEmail
+	return exampleUser
+}
@@ -0,0 +1,17 @@
+module goverter/example
+
+go 1.23.0
+
+toolchain go1.24.5
+
+replace github.com/jmattheis/goverter => ../../
+
+require github.com/jmattheis/goverter v1.3.2
+
+require (
+	github.com/dave/jennifer v1.6.0 // indirect
+	golang.org/x/mod v0.24.0 // indirect
+	golang.org/x/sync v0.12.0 // indirect
+	golang.org/x/tools v0.31.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
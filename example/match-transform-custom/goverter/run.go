@@ -0,0 +1,24 @@
+package main
+
+import (
+	"os"
+	"strings"
+
+	"github.com/jmattheis/goverter/cli"
+	"github.com/jmattheis/goverter/match"
+)
+
+func main() {
+	opts := cli.RunOpts{
+		MatchTransformers: map[string]match.Transformer{
+			"trim-prefix": trimPrefix,
+		},
+	}
+	cli.Run(os.Args, opts)
+}
+
+func trimPrefix(config string) (match.Func, error) {
+	return func(name string) string {
+		return strings.TrimPrefix(name, config)
+	}, nil
+}
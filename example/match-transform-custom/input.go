@@ -0,0 +1,16 @@
+package example
+
+// goverter:converter
+type Converter interface {
+	// goverter:match:transform trim-prefix Col
+	Convert(Row) User
+}
+
+type Row struct {
+	ColName  string
+	ColEmail string
+}
+type User struct {
+	Name  string
+	Email string
+}
@@ -0,0 +1,17 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import matchtransform "github.com/jmattheis/goverter/example/match-transform"
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) Convert(source matchtransform.DbUser) matchtransform.User {
+	var exampleUser matchtransform.User
+	exampleUser.ID = source.DbId
+	exampleUser.Name = source.DbName
+	exampleUser.Homepage = source.DbHomepage
+	exampleUser.APIKeyURL = source.DbApiKeyUrl
+	return exampleUser
+}
@@ -0,0 +1,21 @@
+package example
+
+// goverter:converter
+type Converter interface {
+	// goverter:match:transform regex ^Db(.*) $1
+	// goverter:match:transform initialism
+	Convert(DbUser) User
+}
+
+type DbUser struct {
+	DbId        int
+	DbName      string
+	DbHomepage  string
+	DbApiKeyUrl string
+}
+type User struct {
+	ID        int
+	Name      string
+	Homepage  string
+	APIKeyURL string
+}
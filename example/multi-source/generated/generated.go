@@ -0,0 +1,17 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import multisource "github.com/jmattheis/goverter/example/multi-source"
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) Convert(source multisource.Row, source2 multisource.Config) multisource.DTO {
+	var exampleDTO multisource.DTO
+	exampleDTO.ID = source.ID
+	exampleDTO.Name = source.Name
+	exampleDTO.Endpoint = source2.Host
+	exampleDTO.Version = source2.Version
+	return exampleDTO
+}
@@ -0,0 +1,24 @@
+package example
+
+// goverter:converter
+type Converter interface {
+	// goverter:map cfg.Host Endpoint
+	Convert(row Row, cfg Config) DTO
+}
+
+type Row struct {
+	ID   int
+	Name string
+}
+
+type Config struct {
+	Host    string
+	Version string
+}
+
+type DTO struct {
+	ID       int
+	Name     string
+	Endpoint string
+	Version  string
+}
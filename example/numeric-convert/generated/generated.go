@@ -0,0 +1,29 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import (
+	"fmt"
+	numericconvert "github.com/jmattheis/goverter/example/numeric-convert"
+	"math"
+)
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) Convert(source numericconvert.Input) (numericconvert.Output, error) {
+	var exampleOutput numericconvert.Output
+	if source.ID < 0 || source.ID > math.MaxUint32 {
+		return exampleOutput, fmt.Errorf("ID: value %v does not fit into uint32", source.ID)
+	}
+	exampleOutput.ID = uint32(source.ID)
+	if source.Age < 0 || source.Age > math.MaxUint8 {
+		return exampleOutput, fmt.Errorf("Age: value %v does not fit into uint8", source.Age)
+	}
+	exampleOutput.Age = uint8(source.Age)
+	if source.Rating < -math.MaxFloat32 || source.Rating > math.MaxFloat32 {
+		return exampleOutput, fmt.Errorf("Rating: value %v does not fit into float32", source.Rating)
+	}
+	exampleOutput.Rating = float32(source.Rating)
+	return exampleOutput, nil
+}
@@ -0,0 +1,18 @@
+package example
+
+// goverter:converter
+// goverter:numeric:convert checked
+type Converter interface {
+	Convert(Input) (Output, error)
+}
+
+type Input struct {
+	ID     int64
+	Age    int
+	Rating float64
+}
+type Output struct {
+	ID     uint32
+	Age    uint8
+	Rating float32
+}
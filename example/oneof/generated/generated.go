@@ -0,0 +1,55 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import (
+	"fmt"
+	input "github.com/jmattheis/goverter/example/oneof/input"
+	output "github.com/jmattheis/goverter/example/oneof/output"
+)
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) Convert(source input.Drawing) (output.Drawing, error) {
+	var outputDrawing output.Drawing
+	outputDrawing.Name = source.Name
+	outputShape, err := c.inputShapeToOutputShape(source.Shape)
+	if err != nil {
+		return outputDrawing, err
+	}
+	outputDrawing.Shape = outputShape
+	return outputDrawing, nil
+}
+func (c *ConverterImpl) inputShapeToOutputShape(source input.Shape) (output.Shape, error) {
+	var outputShape output.Shape
+	switch value := source.(type) {
+	case nil:
+		outputShape = nil
+	case *input.Circle:
+		outputShape = c.pInputCircleToPOutputCircle(value)
+	case *input.Rectangle:
+		outputShape = c.pInputRectangleToPOutputSquare(value)
+	default:
+		return outputShape, fmt.Errorf("unexpected oneof type: %T", value)
+	}
+	return outputShape, nil
+}
+func (c *ConverterImpl) pInputCircleToPOutputCircle(source *input.Circle) *output.Circle {
+	var pOutputCircle *output.Circle
+	if source != nil {
+		var outputCircle output.Circle
+		outputCircle.Radius = (*source).Radius
+		pOutputCircle = &outputCircle
+	}
+	return pOutputCircle
+}
+func (c *ConverterImpl) pInputRectangleToPOutputSquare(source *input.Rectangle) *output.Square {
+	var pOutputSquare *output.Square
+	if source != nil {
+		var outputSquare output.Square
+		outputSquare.Width = (*source).Width
+		pOutputSquare = &outputSquare
+	}
+	return pOutputSquare
+}
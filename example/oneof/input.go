@@ -0,0 +1,14 @@
+package example
+
+import (
+	"github.com/jmattheis/goverter/example/oneof/input"
+	"github.com/jmattheis/goverter/example/oneof/output"
+)
+
+// goverter:converter
+// goverter:oneof
+// goverter:oneof:unknown @error
+// goverter:oneof:map Rectangle Square
+type Converter interface {
+	Convert(input.Drawing) (output.Drawing, error)
+}
@@ -0,0 +1,21 @@
+package input
+
+type Drawing struct {
+	Name  string
+	Shape Shape
+}
+
+type Shape interface {
+	isShape()
+}
+
+type Circle struct {
+	Radius float64
+}
+
+type Rectangle struct {
+	Width float64
+}
+
+func (*Circle) isShape()    {}
+func (*Rectangle) isShape() {}
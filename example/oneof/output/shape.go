@@ -0,0 +1,21 @@
+package output
+
+type Drawing struct {
+	Name  string
+	Shape Shape
+}
+
+type Shape interface {
+	isShape()
+}
+
+type Circle struct {
+	Radius float64
+}
+
+type Square struct {
+	Width float64
+}
+
+func (*Circle) isShape() {}
+func (*Square) isShape() {}
@@ -0,0 +1,58 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import (
+	"database/sql"
+	optional "github.com/jmattheis/goverter/example/optional"
+)
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) ToDTO(source optional.User) optional.UserDTO {
+	var exampleUserDTO optional.UserDTO
+	exampleUserDTO.Name = source.Name
+	exampleUserDTO.Email = c.sqlNullStringToPString(source.Email)
+	exampleUserDTO.Nickname = c.exampleOptionalToPString(source.Nickname)
+	return exampleUserDTO
+}
+func (c *ConverterImpl) ToUser(source optional.UserDTO) optional.User {
+	var exampleUser optional.User
+	exampleUser.Name = source.Name
+	exampleUser.Email = c.pStringToSqlNullString(source.Email)
+	exampleUser.Nickname = c.pStringToExampleOptional(source.Nickname)
+	return exampleUser
+}
+func (c *ConverterImpl) exampleOptionalToPString(source optional.Optional[string]) *string {
+	var pString *string
+	if source.Present {
+		xstring := source.Value
+		pString = &xstring
+	}
+	return pString
+}
+func (c *ConverterImpl) pStringToExampleOptional(source *string) optional.Optional[string] {
+	var exampleOptional optional.Optional[string]
+	if source != nil {
+		exampleOptional.Value = *source
+		exampleOptional.Present = true
+	}
+	return exampleOptional
+}
+func (c *ConverterImpl) pStringToSqlNullString(source *string) sql.NullString {
+	var sqlNullString sql.NullString
+	if source != nil {
+		sqlNullString.String = *source
+		sqlNullString.Valid = true
+	}
+	return sqlNullString
+}
+func (c *ConverterImpl) sqlNullStringToPString(source sql.NullString) *string {
+	var pString *string
+	if source.Valid {
+		xstring := source.String
+		pString = &xstring
+	}
+	return pString
+}
@@ -0,0 +1,27 @@
+package example
+
+import "database/sql"
+
+// goverter:converter
+// goverter:optional:wrapper Value Present
+type Converter interface {
+	ToDTO(User) UserDTO
+	ToUser(UserDTO) User
+}
+
+type Optional[T any] struct {
+	Value   T
+	Present bool
+}
+
+type User struct {
+	Name     string
+	Email    sql.NullString
+	Nickname Optional[string]
+}
+
+type UserDTO struct {
+	Name     string
+	Email    *string
+	Nickname *string
+}
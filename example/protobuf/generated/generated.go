@@ -4,8 +4,12 @@
 package generated
 
 import (
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	example "goverter/example"
 	pb "goverter/example/pb"
+	"time"
 )
 
 type ConverterImpl struct{}
@@ -30,3 +34,55 @@ func (c *ConverterImpl) ToProtobuf(source *example.OutputEvent) *pb.Event {
 	}
 	return pPbEvent
 }
+
+type WellKnownConverterImpl struct{}
+
+func (c *WellKnownConverterImpl) FromProtobuf(source *pb.Event) *example.OutputEvent {
+	var pProtobufOutputEvent *example.OutputEvent
+	if source != nil {
+		var protobufOutputEvent example.OutputEvent
+		protobufOutputEvent.Content = source.GetContent()
+		protobufOutputEvent.Priority = source.GetPriority()
+		pProtobufOutputEvent = &protobufOutputEvent
+	}
+	return pProtobufOutputEvent
+}
+func (c *WellKnownConverterImpl) FromSchedule(source example.Schedule) example.OutputSchedule {
+	var protobufOutputSchedule example.OutputSchedule
+	protobufOutputSchedule.StartAt = c.pTimestamppbTimestampToTimeTime(source.StartAt)
+	protobufOutputSchedule.Interval = c.pDurationpbDurationToTimeDuration(source.Interval)
+	protobufOutputSchedule.Comment = c.pWrapperspbStringValueToPString(source.Comment)
+	return protobufOutputSchedule
+}
+func (c *WellKnownConverterImpl) ToSchedule(source example.OutputSchedule) example.Schedule {
+	var protobufSchedule example.Schedule
+	protobufSchedule.StartAt = c.timeTimeToPTimestamppbTimestamp(source.StartAt)
+	protobufSchedule.Interval = durationpb.New(source.Interval)
+	var pWrapperspbStringValue *wrapperspb.StringValue
+	if source.Comment != nil {
+		pWrapperspbStringValue = wrapperspb.String(*source.Comment)
+	}
+	protobufSchedule.Comment = pWrapperspbStringValue
+	return protobufSchedule
+}
+func (c *WellKnownConverterImpl) pDurationpbDurationToTimeDuration(source *durationpb.Duration) time.Duration {
+	return source.AsDuration()
+}
+func (c *WellKnownConverterImpl) pTimestamppbTimestampToTimeTime(source *timestamppb.Timestamp) time.Time {
+	var timeTime time.Time
+	if source != nil {
+		timeTime = source.AsTime()
+	}
+	return timeTime
+}
+func (c *WellKnownConverterImpl) pWrapperspbStringValueToPString(source *wrapperspb.StringValue) *string {
+	var pString *string
+	if source != nil {
+		xstring := source.GetValue()
+		pString = &xstring
+	}
+	return pString
+}
+func (c *WellKnownConverterImpl) timeTimeToPTimestamppbTimestamp(source time.Time) *timestamppb.Timestamp {
+	return timestamppb.New(source)
+}
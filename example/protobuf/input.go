@@ -1,6 +1,14 @@
 package protobuf
 
-import "goverter/example/pb"
+import (
+	"time"
+
+	"goverter/example/pb"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
 
 // goverter:converter
 type Converter interface {
@@ -14,3 +22,23 @@ type OutputEvent struct {
 	Content  string
 	Priority int32
 }
+
+// goverter:converter
+// goverter:proto:wellknown
+type WellKnownConverter interface {
+	FromProtobuf(*pb.Event) *OutputEvent
+	FromSchedule(Schedule) OutputSchedule
+	ToSchedule(OutputSchedule) Schedule
+}
+
+type Schedule struct {
+	StartAt  *timestamppb.Timestamp
+	Interval *durationpb.Duration
+	Comment  *wrapperspb.StringValue
+}
+
+type OutputSchedule struct {
+	StartAt  time.Time
+	Interval time.Duration
+	Comment  *string
+}
@@ -0,0 +1,26 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import (
+	reverse "github.com/jmattheis/goverter/example/reverse"
+	"strconv"
+)
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) ToAPI(source reverse.Repository) reverse.RepositoryAPI {
+	var exampleRepositoryAPI reverse.RepositoryAPI
+	exampleRepositoryAPI.Key = strconv.Itoa(source.ID)
+	exampleRepositoryAPI.Name = source.Name
+	exampleRepositoryAPI.OwnerName = source.Owner.Name
+	return exampleRepositoryAPI
+}
+func (c *ConverterImpl) ToDomain(source reverse.RepositoryAPI) reverse.Repository {
+	var exampleRepository reverse.Repository
+	exampleRepository.ID = reverse.ParseID(source.Key)
+	exampleRepository.Name = source.Name
+	exampleRepository.Owner.Name = source.OwnerName
+	return exampleRepository
+}
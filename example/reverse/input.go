@@ -0,0 +1,36 @@
+package example
+
+import "strconv"
+
+// goverter:converter
+type Converter interface {
+	// goverter:reverse ToDomain
+	// goverter:map ID Key | strconv:Itoa
+	// goverter:map Owner.Name OwnerName
+	// goverter:ignore Links
+	ToAPI(Repository) RepositoryAPI
+
+	// goverter:map Key ID | ParseID
+	ToDomain(RepositoryAPI) Repository
+}
+
+func ParseID(key string) int {
+	id, _ := strconv.Atoi(key)
+	return id
+}
+
+type Repository struct {
+	ID    int
+	Name  string
+	Owner Owner
+}
+type Owner struct {
+	Name string
+}
+
+type RepositoryAPI struct {
+	Key       string
+	Name      string
+	OwnerName string
+	Links     []string
+}
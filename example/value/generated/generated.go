@@ -0,0 +1,21 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package generated
+
+import (
+	value "github.com/jmattheis/goverter/example/value"
+	"time"
+)
+
+type ConverterImpl struct{}
+
+func (c *ConverterImpl) Convert(source value.Input) value.Output {
+	var exampleOutput value.Output
+	exampleOutput.Name = source.Name
+	exampleOutput.Version = 2
+	exampleOutput.Origin = "api"
+	exampleOutput.Status = value.StatusActive
+	exampleOutput.Timeout = time.Minute
+	return exampleOutput
+}
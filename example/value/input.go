@@ -0,0 +1,28 @@
+package example
+
+import "time"
+
+// goverter:converter
+type Converter interface {
+	// goverter:value Version 2
+	// goverter:value Origin "api"
+	// goverter:value Status StatusActive
+	// goverter:value Timeout time:Minute
+	Convert(Input) Output
+}
+
+type Status string
+
+const StatusActive Status = "active"
+
+type Input struct {
+	Name string
+}
+
+type Output struct {
+	Name    string
+	Version int
+	Origin  string
+	Status  Status
+	Timeout time.Duration
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/jmattheis/goverter/namer"
 )
 
+// Header is the first comment of every file generated by goverter.
+const Header = "// Code generated by github.com/jmattheis/goverter, DO NOT EDIT."
+
 type fileManager struct {
 	Files map[string]*managedFile
 }
@@ -38,7 +41,7 @@ func (m *fileManager) Get(conv *config.Converter, cfg Config) (*jen.File, *namer
 			f.Content = jen.NewFilePathName(conv.OutputPackagePath, conv.OutputPackageName)
 		}
 
-		f.Content.HeaderComment("// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.")
+		f.Content.HeaderComment(Header)
 		if cfg.BuildConstraint != "" {
 			f.Content.HeaderComment("//go:build " + cfg.BuildConstraint)
 		}
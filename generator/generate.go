@@ -5,31 +5,45 @@ import (
 	"github.com/jmattheis/goverter/builder"
 	"github.com/jmattheis/goverter/config"
 	"github.com/jmattheis/goverter/namer"
+	"github.com/jmattheis/goverter/report"
 )
 
 // Config the generate config.
 type Config struct {
 	BuildConstraint string
+	// Report is the file the mapping report is written to, can be empty.
+	Report string
 }
 
 // BuildSteps that'll used for generation.
 var BuildSteps = []builder.Builder{
 	&builder.UseUnderlyingTypeMethods{},
 	&builder.SkipCopy{},
+	&builder.EnumFlags{},
 	&builder.Enum{},
+	&builder.EnumString{},
+	&builder.ProtoWellKnown{},
+	&builder.Optional{},
 	&builder.BasicTargetPointerRule{},
 	&builder.Pointer{},
 	&builder.SourcePointer{},
 	&builder.TargetPointer{},
+	&builder.Time{},
 	&builder.Basic{},
+	&builder.Numeric{},
+	&builder.Strconv{},
+	&builder.Oneof{},
 	&builder.Struct{},
 	&builder.List{},
 	&builder.Map{},
+	&builder.ListToMap{},
+	&builder.MapToSlice{},
 }
 
 // Generate generates a jen.File containing converters.
 func Generate(converters []*config.Converter, c Config) (map[string][]byte, error) {
 	manager := &fileManager{Files: map[string]*managedFile{}}
+	mappingReport := &report.Report{Converters: []*report.Converter{}}
 
 	for _, converter := range converters {
 		jenFile, n, err := manager.Get(converter, c)
@@ -37,26 +51,34 @@ func Generate(converters []*config.Converter, c Config) (map[string][]byte, erro
 			return nil, err
 		}
 
-		if err := generateConverter(converter, jenFile, n); err != nil {
+		converterReport, err := generateConverter(converter, jenFile, n)
+		if err != nil {
 			return nil, err
 		}
+		mappingReport.Converters = append(mappingReport.Converters, converterReport)
+	}
+
+	files, err := manager.renderFiles()
+	if err != nil || c.Report == "" {
+		return files, err
 	}
 
-	return manager.renderFiles()
+	files[c.Report], err = mappingReport.Render(c.Report)
+	return files, err
 }
 
-func generateConverter(converter *config.Converter, f *jen.File, n *namer.Namer) error {
+func generateConverter(converter *config.Converter, f *jen.File, n *namer.Namer) (*report.Converter, error) {
 	gen, err := setupGenerator(converter, n)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if err := validateMethods(gen.lookup); err != nil {
-		return err
+	if err := validateMethods(gen); err != nil {
+		return nil, err
 	}
 
 	if err := gen.buildMethods(f); err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return gen.report(), nil
 }
@@ -9,8 +9,10 @@ import (
 	"github.com/dave/jennifer/jen"
 	"github.com/jmattheis/goverter/builder"
 	"github.com/jmattheis/goverter/config"
+	"github.com/jmattheis/goverter/diagnostic"
 	"github.com/jmattheis/goverter/method"
 	"github.com/jmattheis/goverter/namer"
+	"github.com/jmattheis/goverter/report"
 	"github.com/jmattheis/goverter/xtype"
 )
 
@@ -24,6 +26,7 @@ type generatedMethod struct {
 	Jen        jen.Code
 
 	IndexID method.IndexID
+	Report  *report.Method
 }
 
 type generator struct {
@@ -65,12 +68,42 @@ func (g *generator) buildDirtyMethods() error {
 				SourceType: genMethod.Source.String,
 				TargetType: genMethod.Target.String,
 			})
-			return fmt.Errorf("Error while creating converter method:\n    %s\n    %s%s\n\n%s", genMethod.Location, genMethod.ID, genMethod.Definition.ArgDebug("        "), builder.ToString(err))
+			return &diagnostic.Error{
+				Location:  genMethod.Location,
+				Converter: g.conf.IDString(),
+				Method:    genMethod.ID,
+				Path:      diagnosticPath(err),
+				Cause:     err.Cause,
+				Message:   fmt.Sprintf("Error while creating converter method:\n    %s\n    %s%s\n\n%s", genMethod.Location, genMethod.ID, genMethod.Definition.ArgDebug("        "), builder.ToString(err)),
+			}
 		}
 	}
 	return nil
 }
 
+func diagnosticPath(err *builder.Error) []diagnostic.Path {
+	path := make([]diagnostic.Path, 0, len(err.Path))
+	for _, p := range err.Path {
+		path = append(path, diagnostic.Path{
+			SourceID:   p.SourceID,
+			SourceType: p.SourceType,
+			TargetID:   p.TargetID,
+			TargetType: p.TargetType,
+		})
+	}
+	return path
+}
+
+func (g *generator) report() *report.Converter {
+	r := &report.Converter{Converter: g.conf.IDString(), Methods: []*report.Method{}}
+	for _, genMethod := range g.getGenMethods() {
+		if genMethod.Report != nil && len(genMethod.Report.Fields) > 0 {
+			r.Methods = append(r.Methods, genMethod.Report)
+		}
+	}
+	return r
+}
+
 func (g *generator) anyDirty() bool {
 	for _, m := range g.getGenMethods() {
 		if m.Dirty {
@@ -143,6 +176,10 @@ func (g *generator) buildMethod(genMethod *generatedMethod, context map[string]*
 		HasMethod:         g.hasMethod,
 		OutputPackagePath: g.conf.OutputPackagePath,
 		UseConstructor:    genMethod.Constructor != nil,
+		Report:            &report.Method{Name: genMethod.Name, ID: genMethod.ID},
+	}
+	if genMethod.Constructor != nil {
+		ctx.Report.Default = genMethod.Constructor.QualifiedName()
 	}
 
 	var targetAssign *jen.Statement
@@ -155,16 +192,25 @@ func (g *generator) buildMethod(genMethod *generatedMethod, context map[string]*
 			name := ctx.Name("context")
 			ctx.Context[arg.Type.String] = xtype.VariableID(jen.Id(name))
 			args = append(args, jen.Id(name).Add(arg.Type.TypeAsJen()))
-		case method.ArgUseSource:
+		case method.ArgUseSource, method.ArgUseMultiSource:
 			name := ctx.Name("source")
-			sourceID = xtype.VariableID(jen.Id(name))
+			id := xtype.VariableID(jen.Id(name))
+			if arg.Use == method.ArgUseSource {
+				sourceID = id
+			}
 			args = append(args, jen.Id(name).Add(arg.Type.TypeAsJen()))
+
+			if len(genMethod.MultiSources) > 0 {
+				paramName := arg.Name
+				if paramName == "" || paramName == "_" {
+					paramName = name
+				}
+				ctx.SourceParams = append(ctx.SourceParams, &builder.SourceParam{Name: paramName, ID: id, Type: arg.Type})
+			}
 		case method.ArgUseTarget:
 			name := ctx.Name("target")
 			targetAssign = jen.Id(name)
 			args = append(args, jen.Id(name).Add(arg.Type.TypeAsJen()))
-		case method.ArgUseMultiSource:
-			panic("multi source aren't supported right now. https://github.com/jmattheis/goverter/issues/143")
 		}
 	}
 
@@ -185,35 +231,61 @@ func (g *generator) buildMethod(genMethod *generatedMethod, context map[string]*
 			return err
 		}
 
+		if target.Pointer {
+			before, after := g.hooks(source, target.PointerInner)
+			beforeStmt, err := g.callHooks(ctx, before, sourceID, targetAssign)
+			if err != nil {
+				return err
+			}
+			afterStmt, err := g.callHooks(ctx, after, sourceID, targetAssign)
+			if err != nil {
+				return err
+			}
+			funcBlock = append(append(beforeStmt, funcBlock...), afterStmt...)
+		}
+
 		if genMethod.ReturnError {
-			funcBlock = append(funcBlock, jen.Return().Nil())
+			funcBlock = append(funcBlock, jen.Return(collectedErrors(ctx)))
 		}
-	} else if def, err := g.extend.Get(ctx.Signature, context); def != nil {
+	} else if def, err := g.extend.Get(ctx.Signature, context); def != nil && len(genMethod.MultiSources) == 0 {
 		jenReturn, err := g.delegateMethod(ctx, def, sourceID)
 		if err != nil {
 			return err
 		}
 		funcBlock = []jen.Code{jenReturn}
-	} else if err != nil {
+	} else if err != nil && len(genMethod.MultiSources) == 0 {
 		return builder.NewError(err.Error())
 	} else {
-		stmt, newID, err := g.buildNoLookup(ctx, sourceID, source, target, nil)
+		stmt, newID, err := g.buildHooked(ctx, sourceID, source, target)
 		if err != nil {
 			return err
 		}
 		ret := []jen.Code{newID.Code}
 		if genMethod.ReturnError {
-			ret = append(ret, jen.Nil())
+			ret = append(ret, collectedErrors(ctx))
 		}
 
 		funcBlock = append(stmt, jen.Return(ret...))
 	}
 
+	if ctx.ErrorsVar != nil {
+		funcBlock = append([]jen.Code{jen.Var().Add(ctx.ErrorsVar.Clone()).Index().Error()}, funcBlock...)
+	}
+
 	genMethod.Jen = jen.Params(args...).Params(returns...).Block(funcBlock...)
+	genMethod.Report = ctx.Report
 
 	return nil
 }
 
+// collectedErrors returns the error returned at the end of the method.
+func collectedErrors(ctx *builder.MethodContext) jen.Code {
+	if ctx.ErrorsVar == nil {
+		return jen.Nil()
+	}
+	return jen.Qual("errors", "Join").Call(ctx.ErrorsVar.Clone().Op("..."))
+}
+
 func (g *generator) buildNoLookup(ctx *builder.MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, errPath builder.ErrorPath) ([]jen.Code, *xtype.JenID, *builder.Error) {
 	if err := g.getOverlappingStructDefinition(ctx, source, target); err != nil {
 		return nil, nil, err
@@ -294,7 +366,7 @@ func (g *generator) CallMethod(
 			}
 			params = append(params, sourceID.Code)
 		case method.ArgUseMultiSource:
-			panic("multi source aren't supported right now. https://github.com/jmattheis/goverter/issues/143")
+			panic("unreachable: methods with multiple source params cannot be called")
 		case method.ArgUseTarget:
 			panic("unreachable")
 		}
@@ -326,6 +398,14 @@ func (g *generator) CallMethod(
 }
 
 func (g *generator) ReturnError(ctx *builder.MethodContext, errPath builder.ErrorPath, id *jen.Statement) (jen.Code, bool) {
+	return g.returnError(ctx, errPath, id, false)
+}
+
+func (g *generator) ReturnGuardError(ctx *builder.MethodContext, errPath builder.ErrorPath, id *jen.Statement) (jen.Code, bool) {
+	return g.returnError(ctx, errPath, id, true)
+}
+
+func (g *generator) returnError(ctx *builder.MethodContext, errPath builder.ErrorPath, id *jen.Statement, guard bool) (jen.Code, bool) {
 	current := g.lookup.ByID(ctx.IndexID)
 	if !ctx.Conf.ReturnError {
 		for _, path := range append([]method.IndexID{ctx.IndexID}, current.OriginPath...) {
@@ -340,12 +420,31 @@ func (g *generator) ReturnError(ctx *builder.MethodContext, errPath builder.Erro
 			}
 		}
 	}
+	if ctx.Conf.ErrorsCollect {
+		if guard {
+			if ctx.ErrorsVar == nil {
+				// no errors can be collected before this guard.
+				return returnTarget(current, ctx, g.wrap(ctx, errPath, id)), true
+			}
+			return returnTarget(current, ctx, jen.Qual("errors", "Join").Call(
+				jen.Append(ctx.ErrorsVar.Clone(), g.wrap(ctx, errPath, id)).Op("..."))), true
+		}
+		if ctx.ErrorsVar == nil {
+			ctx.ErrorsVar = jen.Id(ctx.Name("errs"))
+		}
+		return ctx.ErrorsVar.Clone().Op("=").Append(ctx.ErrorsVar.Clone(), g.wrap(ctx, errPath, id)), true
+	}
+
+	return returnTarget(current, ctx, g.wrap(ctx, errPath, id)), true
+}
+
+func returnTarget(current *generatedMethod, ctx *builder.MethodContext, err jen.Code) jen.Code {
 	returns := []jen.Code{}
 	if !current.UpdateTarget {
 		returns = append(returns, ctx.TargetVar)
 	}
-	returns = append(returns, g.wrap(ctx, errPath, id))
-	return jen.Return(returns...), true
+	returns = append(returns, err)
+	return jen.Return(returns...)
 }
 
 func (g *generator) requireContext(ctx *builder.MethodContext, need *xtype.Type) bool {
@@ -392,7 +491,7 @@ func (g *generator) delegateMethod(
 		case method.ArgUseSource:
 			params = append(params, sourceID.Code)
 		case method.ArgUseMultiSource:
-			panic("not supported atm")
+			panic("unreachable: extend methods cannot have multiple source params")
 		case method.ArgUseTarget:
 			panic("unreachable")
 		}
@@ -419,6 +518,8 @@ func (g *generator) wrap(ctx *builder.MethodContext, errPath builder.ErrorPath,
 	switch {
 	case ctx.Conf.WrapErrorsUsing != "":
 		return errPath.WrapErrorsUsing(ctx.Conf.WrapErrorsUsing, errStmt)
+	case ctx.Conf.ErrorsCollect:
+		return errPath.WrapErrorsPath(errStmt)
 	case ctx.Conf.WrapErrors:
 		return errPath.WrapErrors(errStmt)
 	default:
@@ -519,12 +620,27 @@ func (g *generator) shouldCreateSubMethod(ctx *builder.MethodContext, source, ta
 		if ctx.Conf.SkipCopySameType && types.Identical(source.T, target.T) {
 			createSubMethod = false
 		}
+		if !typeAccessible(source, ctx.OutputPackagePath) || !typeAccessible(target, ctx.OutputPackagePath) {
+			// the method signature cannot reference the type, f.ex. a protobuf oneof interface.
+			createSubMethod = false
+		}
+	}
+	if !createSubMethod && g.hasHooks(source, target) && !ctx.Signature.Identical(xtype.SignatureOf(source, target)) {
+		// hooks are called in the method of the type pair.
+		createSubMethod = true
 	}
 	ctx.MarkSeen(source)
 
 	return createSubMethod
 }
 
+func typeAccessible(t *xtype.Type, outputPackagePath string) bool {
+	if t.Pointer {
+		t = t.PointerInner
+	}
+	return !t.Named || xtype.Accessible(t.NamedType.Obj(), outputPackagePath)
+}
+
 func (g *generator) createSubMethod(ctx *builder.MethodContext, sourceID *xtype.JenID, source, target *xtype.Type, errPAth builder.ErrorPath) ([]jen.Code, *xtype.JenID, *builder.Error) {
 	name := g.namer.Name(source.UnescapedID() + "To" + strings.Title(target.UnescapedID()))
 	orig := g.lookup.ByID(ctx.IndexID)
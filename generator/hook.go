@@ -0,0 +1,155 @@
+package generator
+
+import (
+	"fmt"
+	"go/types"
+	"sort"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/jmattheis/goverter/builder"
+	"github.com/jmattheis/goverter/config"
+	"github.com/jmattheis/goverter/method"
+	"github.com/jmattheis/goverter/xtype"
+)
+
+// hooks returns the before and after hooks defined on the converter methods
+// for conversions from source to *target.
+func (g *generator) hooks(source, target *xtype.Type) (before, after []*method.Definition) {
+	methods := append([]*config.Method{}, g.conf.Methods...)
+	sort.SliceStable(methods, func(i, j int) bool {
+		return methods[i].ID < methods[j].ID
+	})
+
+	seen := map[string]struct{}{}
+	filter := func(hooks []*method.Definition) []*method.Definition {
+		var result []*method.Definition
+		for _, hook := range hooks {
+			if _, ok := seen[hook.ID]; ok {
+				continue
+			}
+			if types.Identical(hook.Source.T, source.T) && types.Identical(hook.Target.PointerInner.T, target.T) {
+				seen[hook.ID] = struct{}{}
+				result = append(result, hook)
+			}
+		}
+		return result
+	}
+
+	for _, m := range methods {
+		before = append(before, filter(m.Before)...)
+	}
+	seen = map[string]struct{}{}
+	for _, m := range methods {
+		after = append(after, filter(m.After)...)
+	}
+	return before, after
+}
+
+func (g *generator) hasHooks(source, target *xtype.Type) bool {
+	before, after := g.hooks(source, target)
+	return len(before) > 0 || len(after) > 0
+}
+
+// buildHooked builds the conversion from source to target and calls the
+// before and after hooks with the target variable.
+func (g *generator) buildHooked(ctx *builder.MethodContext, sourceID *xtype.JenID, source, target *xtype.Type) ([]jen.Code, *xtype.JenID, *builder.Error) {
+	before, after := g.hooks(source, target)
+	if len(before) == 0 && len(after) == 0 {
+		return g.buildNoLookup(ctx, sourceID, source, target, nil)
+	}
+
+	var stmt []jen.Code
+	var targetID *xtype.JenID
+	if len(before) == 0 {
+		var err *builder.Error
+		stmt, targetID, err = g.buildNoLookup(ctx, sourceID, source, target, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !targetID.Variable {
+			name := ctx.Name(target.ID())
+			stmt = append(stmt, jen.Id(name).Op(":=").Add(targetID.Code))
+			targetID = xtype.VariableID(jen.Id(name))
+		}
+	} else {
+		if err := g.getOverlappingStructDefinition(ctx, source, target); err != nil {
+			return nil, nil, err
+		}
+		var assignTo *builder.AssignTo
+		var err *builder.Error
+		stmt, assignTo, err = builder.BuildTargetVar(g, ctx, sourceID, source, target, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		targetID = xtype.VariableID(assignTo.Stmt.Clone())
+
+		hookStmt, err := g.callHooks(ctx, before, sourceID, jen.Op("&").Add(targetID.Code.Clone()))
+		if err != nil {
+			return nil, nil, err
+		}
+		stmt = append(stmt, hookStmt...)
+
+		assignStmt, err := g.assignNoLookup(ctx, assignTo, sourceID, source, target, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		stmt = append(stmt, assignStmt...)
+	}
+
+	hookStmt, err := g.callHooks(ctx, after, sourceID, jen.Op("&").Add(targetID.Code.Clone()))
+	if err != nil {
+		return nil, nil, err
+	}
+	return append(stmt, hookStmt...), targetID, nil
+}
+
+func (g *generator) callHooks(ctx *builder.MethodContext, hooks []*method.Definition, sourceID *xtype.JenID, targetPtr *jen.Statement) ([]jen.Code, *builder.Error) {
+	var stmt []jen.Code
+	for _, hook := range hooks {
+		hookStmt, err := g.callHook(ctx, hook, sourceID, targetPtr)
+		if err != nil {
+			return nil, err
+		}
+		stmt = append(stmt, hookStmt)
+	}
+	return stmt, nil
+}
+
+func (g *generator) callHook(ctx *builder.MethodContext, hook *method.Definition, sourceID *xtype.JenID, targetPtr *jen.Statement) (jen.Code, *builder.Error) {
+	formatErr := func(s string) *builder.Error {
+		return builder.NewError(fmt.Sprintf("Error using hook:\n    %s%s\n\n%s", hook.ID, hook.ArgDebug("        "), s))
+	}
+
+	params := []jen.Code{}
+	for _, arg := range hook.RawArgs {
+		switch arg.Use {
+		case method.ArgUseInterface:
+			params = append(params, jen.Id(xtype.ThisVar))
+		case method.ArgUseContext:
+			if !g.requireContext(ctx, arg.Type) {
+				return nil, formatErr("Could not satisfy all required context parameters:\n" + strings.Join(method.AvailableContextDebug(hook.Context, ctx.AvailableContext), "\n"))
+			}
+			if id, ok := ctx.Context[arg.Type.String]; ok {
+				params = append(params, id.Code.Clone())
+			}
+		case method.ArgUseSource:
+			params = append(params, sourceID.Code.Clone())
+		case method.ArgUseTarget:
+			params = append(params, targetPtr.Clone())
+		case method.ArgUseMultiSource:
+			panic("unreachable: hooks cannot have multiple source params")
+		}
+	}
+
+	call := g.qualMethod(hook).Call(params...)
+	if !hook.ReturnError {
+		return call, nil
+	}
+
+	ret, ok := g.ReturnError(ctx, nil, jen.Id("err"))
+	if !ok {
+		return nil, formatErr("Used hook returns error but conversion method does not")
+	}
+	return jen.If(jen.Id("err").Op(":=").Add(call), jen.Id("err").Op("!=").Nil()).Block(ret), nil
+}
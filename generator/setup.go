@@ -20,7 +20,9 @@ func setupGenerator(converter *config.Converter, n *namer.Namer) (*generator, er
 			Dirty:    true,
 			Explicit: true,
 		}
-		if gen.UpdateTarget {
+		// Methods with multiple source params cannot be reused for other
+		// conversions because the additional sources are unknown.
+		if gen.UpdateTarget || len(gen.MultiSources) > 0 {
 			gen.IndexID, err = lookup.RegisterUpdate(gen, gen.Definition)
 		} else {
 			gen.IndexID, err = lookup.Register(gen, gen.Definition)
@@ -3,21 +3,36 @@ package generator
 import (
 	"fmt"
 
-	"github.com/jmattheis/goverter/method"
+	"github.com/jmattheis/goverter/diagnostic"
 )
 
-func validateMethods(lookup *method.Index[generatedMethod]) error {
-	for _, hits := range lookup.Exact.Values() {
-		for _, entry := range hits {
-			genMethod := entry.Item
+func validateMethods(gen *generator) error {
+	for _, genMethod := range gen.lookup.GetAll() {
+		if genMethod.Explicit && len(genMethod.MultiSources) > 0 && !isStructTarget(genMethod) {
+			return methodError(gen, genMethod, "Invalid multiple source params on method",
+				"Multiple source params may only be used on methods with a struct or struct pointer target.\nSee https://goverter.jmattheis.de/reference/signature#signature-multiple-source-params")
+		}
 
-			if genMethod.Explicit && len(genMethod.RawFieldSettings) > 0 {
-				isTargetStructPointer := genMethod.Target.Pointer && genMethod.Parameters.Target.PointerInner.Struct
-				if !genMethod.Target.Struct && !isTargetStructPointer {
-					return fmt.Errorf("Invalid struct field mapping on method:\n    %s\n    %s\n\nField mappings like goverter:map or goverter:ignore may only be set on struct or struct pointers.\nSee https://goverter.jmattheis.de/guide/configure-nested", genMethod.Location, genMethod.ID)
-				}
+		if genMethod.Explicit && len(genMethod.RawFieldSettings) > 0 {
+			if !isStructTarget(genMethod) {
+				return methodError(gen, genMethod, "Invalid struct field mapping on method",
+					"Field mappings like goverter:map or goverter:ignore may only be set on struct or struct pointers.\nSee https://goverter.jmattheis.de/guide/configure-nested")
 			}
 		}
 	}
 	return nil
 }
+
+func methodError(gen *generator, genMethod *generatedMethod, title, cause string) error {
+	return &diagnostic.Error{
+		Location:  genMethod.Location,
+		Converter: gen.conf.IDString(),
+		Method:    genMethod.ID,
+		Cause:     cause,
+		Message:   fmt.Sprintf("%s:\n    %s\n    %s\n\n%s", title, genMethod.Location, genMethod.ID, cause),
+	}
+}
+
+func isStructTarget(genMethod *generatedMethod) bool {
+	return genMethod.Target.Struct || (genMethod.Target.Pointer && genMethod.Target.PointerInner.Struct)
+}
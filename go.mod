@@ -7,6 +7,7 @@ toolchain go1.25.0
 require (
 	github.com/dave/jennifer v1.6.0
 	github.com/stretchr/testify v1.8.1
+	golang.org/x/mod v0.24.0
 	golang.org/x/tools v0.31.0
 	gopkg.in/yaml.v3 v3.0.1
 )
@@ -14,6 +15,5 @@ require (
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	golang.org/x/mod v0.24.0 // indirect
 	golang.org/x/sync v0.12.0 // indirect
 )
@@ -0,0 +1,13 @@
+// Package match provides transformers for matching struct fields by name.
+package match
+
+// Transformer creates a function that transforms field names. A source and
+// target field match, if their names are equal after the transformation.
+//
+// The config is user definable and is passed when the setting is parsed. An
+// error by this method aborts the whole goverter conversion, so only use it
+// when there are config errors.
+type Transformer func(config string) (Func, error)
+
+// Func transforms a field name.
+type Func func(name string) string
@@ -0,0 +1,70 @@
+package match
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/jmattheis/goverter/strcase"
+)
+
+var DefaultTransformers = map[string]Transformer{
+	"regex":      transformRegex,
+	"snake":      transformSnake,
+	"camel":      transformCamel,
+	"initialism": transformInitialism,
+}
+
+// DefaultInitialisms are used by the initialism transformer.
+var DefaultInitialisms = []string{
+	"API", "ASCII", "CPU", "CSS", "DB", "DNS", "EOF", "GUID", "HTML", "HTTP",
+	"HTTPS", "ID", "IP", "JSON", "LHS", "QPS", "RAM", "RHS", "RPC", "SLA",
+	"SMTP", "SQL", "SSH", "TCP", "TLS", "TTL", "UDP", "UI", "UID", "URI",
+	"URL", "UTF8", "UUID", "VM", "XML", "XSRF", "XSS",
+}
+
+func transformRegex(config string) (Func, error) {
+	parts := strings.Split(config, " ")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid config, expected two strings separated by space")
+	}
+
+	pattern, err := regexp.Compile(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %w", parts[0], err)
+	}
+
+	return func(name string) string {
+		return pattern.ReplaceAllString(name, parts[1])
+	}, nil
+}
+
+func transformSnake(config string) (Func, error) {
+	if config != "" {
+		return nil, fmt.Errorf("invalid config, snake has no config")
+	}
+	return strcase.Snake, nil
+}
+
+func transformCamel(config string) (Func, error) {
+	if config != "" {
+		return nil, fmt.Errorf("invalid config, camel has no config")
+	}
+	return strcase.Camel, nil
+}
+
+func transformInitialism(config string) (Func, error) {
+	initialisms := map[string]struct{}{}
+	for _, initialism := range append(DefaultInitialisms, strings.Fields(config)...) {
+		initialisms[strings.ToUpper(initialism)] = struct{}{}
+	}
+
+	return func(name string) string {
+		return strcase.MapWords(name, func(word string) string {
+			if _, ok := initialisms[strings.ToUpper(word)]; ok {
+				return strings.ToUpper(word)
+			}
+			return word
+		})
+	}, nil
+}
@@ -17,6 +17,14 @@ type Definition struct {
 	CustomCall *jen.Statement
 }
 
+// QualifiedName returns the name of the method prefixed with its package.
+func (d *Definition) QualifiedName() string {
+	if d.Package == "" {
+		return d.Name
+	}
+	return d.Package + "." + d.Name
+}
+
 type Parameters struct {
 	TypeParams bool
 
@@ -1,6 +1,7 @@
 package method
 
 import (
+	"errors"
 	"fmt"
 	"go/types"
 	"regexp"
@@ -33,6 +34,8 @@ type ParseOpts struct {
 	Generated   bool
 	CustomCall  *jen.Statement
 	UpdateParam string
+	// TargetParam parses the first param after the source as target.
+	TargetParam bool
 }
 
 type LocalOpts struct {
@@ -88,21 +91,16 @@ func Parse(obj types.Object, opts *ParseOpts, localOpts LocalOpts) (*Definition,
 		case types.Identical(arg.Type.T, opts.Converter):
 			arg.Use = ArgUseInterface
 		case opts.UpdateParam != "" && arg.Name == opts.UpdateParam:
-			arg.Use = ArgUseTarget
-			methodDef.Target = arg.Type
-			methodDef.UpdateTarget = true
-
-			switch {
-			case resultsLen == 0:
-				// okay nothing more
-			case resultsLen == 1 && isError(sig.Results().At(0)):
-				methodDef.ReturnError = true
-			default:
-				return nil, formatErr("The signature one non 'error' result or multiple results is not supported for goverter:update signatures.")
+			if err := parseTarget(methodDef, &arg, sig); err != nil {
+				return nil, formatErr(err.Error())
 			}
 		case (opts.ContextMatch != nil && opts.ContextMatch.MatchString(arg.Name)) || localOpts.Context[arg.Name]:
 			methodDef.Context[arg.Type.String] = arg.Type
 			arg.Use = ArgUseContext
+		case opts.TargetParam && methodDef.Source != nil && !methodDef.UpdateTarget:
+			if err := parseTarget(methodDef, &arg, sig); err != nil {
+				return nil, formatErr(err.Error())
+			}
 		case methodDef.Source == nil:
 			arg.Use = ArgUseSource
 			methodDef.Source = arg.Type
@@ -118,6 +116,15 @@ func Parse(obj types.Object, opts *ParseOpts, localOpts LocalOpts) (*Definition,
 		return nil, formatErr(fmt.Sprintf("Argument %q must exist when using 'goverter:target %s'", opts.UpdateParam, opts.UpdateParam))
 	}
 
+	if opts.TargetParam {
+		if !methodDef.UpdateTarget {
+			return nil, formatErr("must have a target param after the source param")
+		}
+		if !methodDef.Target.Pointer {
+			return nil, formatErr("target param must be a pointer but is: " + methodDef.Target.String)
+		}
+	}
+
 	if !methodDef.UpdateTarget {
 		if resultsLen == 0 || resultsLen > 2 {
 			return nil, formatErr("must have one or two returns")
@@ -151,6 +158,23 @@ func Parse(obj types.Object, opts *ParseOpts, localOpts LocalOpts) (*Definition,
 	return methodDef, nil
 }
 
+// parseTarget uses arg as target param of methodDef.
+func parseTarget(methodDef *Definition, arg *Arg, sig *types.Signature) error {
+	arg.Use = ArgUseTarget
+	methodDef.Target = arg.Type
+	methodDef.UpdateTarget = true
+
+	switch {
+	case sig.Results().Len() == 0:
+		// okay nothing more
+	case sig.Results().Len() == 1 && isError(sig.Results().At(0)):
+		methodDef.ReturnError = true
+	default:
+		return errors.New("The signature one non 'error' result or multiple results is not supported for goverter:update signatures.")
+	}
+	return nil
+}
+
 func isError(obj *types.Var) bool {
 	t, ok := obj.Type().(*types.Named)
 	return ok && t.Obj().Name() == "error" && t.Obj().Pkg() == nil
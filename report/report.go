@@ -0,0 +1,111 @@
+// Package report records how goverter assigns the fields of target structs.
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Kind describes where a target field gets its value from.
+type Kind string
+
+const (
+	// KindMap is used for fields mapped via goverter:map.
+	KindMap Kind = "map"
+	// KindAuto is used for fields matched by name.
+	KindAuto Kind = "auto"
+	// KindTag is used for fields matched by struct tag via goverter:match:tag.
+	KindTag Kind = "tag"
+	// KindAutoMap is used for fields matched by name via goverter:autoMap.
+	KindAutoMap Kind = "autoMap"
+	// KindFunction is used for fields mapped with a custom function.
+	KindFunction Kind = "function"
+	// KindValue is used for fields set to a constant via goverter:value.
+	KindValue Kind = "value"
+	// KindIgnore is used for fields ignored via goverter:ignore.
+	KindIgnore Kind = "ignore"
+	// KindIgnoreMissing is used for fields skipped via goverter:ignoreMissing.
+	KindIgnoreMissing Kind = "ignoreMissing"
+	// KindIgnoreUnexported is used for fields skipped via goverter:ignoreUnexported.
+	KindIgnoreUnexported Kind = "ignoreUnexported"
+)
+
+// Report contains the field mappings of all converters.
+type Report struct {
+	Converters []*Converter `json:"converters"`
+}
+
+// Converter contains the field mappings of the methods of one converter.
+type Converter struct {
+	Converter string    `json:"converter"`
+	Methods   []*Method `json:"methods"`
+}
+
+// Method contains the field mappings of one conversion method.
+type Method struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+	// Default is the function creating the target instance, see goverter:default.
+	Default string   `json:"default,omitempty"`
+	Fields  []*Field `json:"fields"`
+}
+
+// Field describes where the value of one target field comes from.
+type Field struct {
+	// Target is the path of the target field relative to the method target.
+	Target string `json:"target"`
+	// Source is the path of the source field relative to the source struct.
+	Source   string `json:"source,omitempty"`
+	Function string `json:"function,omitempty"`
+	Kind     Kind   `json:"kind"`
+}
+
+// Add records a field mapping.
+func (m *Method) Add(field *Field) {
+	m.Fields = append(m.Fields, field)
+}
+
+// Render renders the report as JSON if fileName has the .json extension and
+// as Markdown otherwise.
+func (r *Report) Render(fileName string) ([]byte, error) {
+	if strings.EqualFold(filepath.Ext(fileName), ".json") {
+		content, err := json.MarshalIndent(r, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(content, '\n'), nil
+	}
+	return r.markdown(), nil
+}
+
+func (r *Report) markdown() []byte {
+	var buf bytes.Buffer
+	buf.WriteString("# Goverter Mapping Report\n")
+	for _, c := range r.Converters {
+		fmt.Fprintf(&buf, "\n## %s\n", c.Converter)
+		for _, m := range c.Methods {
+			fmt.Fprintf(&buf, "\n### %s\n\n", m.Name)
+			if m.ID != m.Name {
+				fmt.Fprintf(&buf, "`%s`\n\n", m.ID)
+			}
+			if m.Default != "" {
+				fmt.Fprintf(&buf, "Default: `%s`\n\n", m.Default)
+			}
+			buf.WriteString("| Target | Source | Function | Kind |\n| --- | --- | --- | --- |\n")
+			for _, f := range m.Fields {
+				fmt.Fprintf(&buf, "| %s | %s | %s | %s |\n", code(f.Target), code(f.Source), code(f.Function), f.Kind)
+			}
+		}
+	}
+	return buf.Bytes()
+}
+
+func code(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "`" + s + "`"
+}
@@ -3,11 +3,13 @@ package goverter
 import (
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/jmattheis/goverter/comments"
 	"github.com/jmattheis/goverter/config"
 	"github.com/jmattheis/goverter/enum"
 	"github.com/jmattheis/goverter/generator"
+	"github.com/jmattheis/goverter/match"
 )
 
 // GenerateConfig the config for generating a converter.
@@ -24,6 +26,18 @@ type GenerateConfig struct {
 	OutputBuildConstraint string
 	// EnumTransformers describes additional enum transformers usable in the enum:transform setting.
 	EnumTransformers map[string]enum.Transformer
+	// MatchTransformers describes additional field name transformers usable in the match:transform setting.
+	MatchTransformers map[string]match.Transformer
+	// Report is the file the field mapping report is written to. The report
+	// is written as JSON if the file has the .json extension and as Markdown
+	// otherwise. A relative path is resolved against WorkingDir. Can be empty.
+	Report string
+	// CheckExtra enables the detection of extra files in CheckConverters.
+	// These are files in the output directories that contain the goverter
+	// header but aren't generated anymore. Only enable it, if every converter
+	// writing into these directories is checked, otherwise their files are
+	// reported as extra.
+	CheckExtra bool
 }
 
 // GenerateConverters generates converters.
@@ -37,8 +51,22 @@ func GenerateConverters(c *GenerateConfig) error {
 }
 
 func generateConvertersRaw(c *GenerateConfig) (map[string][]byte, error) {
+	file, err := config.LoadFile(c.WorkingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	buildTags := c.BuildTags
+	if file != nil && len(file.BuildTags) > 0 {
+		tags := file.BuildTags
+		if buildTags != "" {
+			tags = append([]string{buildTags}, tags...)
+		}
+		buildTags = strings.Join(tags, ",")
+	}
+
 	rawConverters, err := comments.ParseDocs(comments.ParseDocsConfig{
-		BuildTags:      c.BuildTags,
+		BuildTags:      buildTags,
 		PackagePattern: c.PackagePatterns,
 		WorkingDir:     c.WorkingDir,
 	})
@@ -47,21 +75,32 @@ func generateConvertersRaw(c *GenerateConfig) (map[string][]byte, error) {
 	}
 
 	converters, err := config.Parse(&config.Raw{
-		BuildTags:  c.BuildTags,
+		BuildTags:  buildTags,
 		WorkDir:    c.WorkingDir,
 		Converters: rawConverters,
 		Global:     c.Global,
+		File:       file,
 
 		OuputBuildConstraint: c.OutputBuildConstraint,
 
-		EnumTransformers: c.EnumTransformers,
+		EnumTransformers:  c.EnumTransformers,
+		MatchTransformers: c.MatchTransformers,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	reportFile := c.Report
+	if reportFile != "" && !filepath.IsAbs(reportFile) {
+		reportFile, err = filepath.Abs(filepath.Join(c.WorkingDir, reportFile))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return generator.Generate(converters, generator.Config{
 		BuildConstraint: c.OutputBuildConstraint,
+		Report:          reportFile,
 	})
 }
 
@@ -1,6 +1,7 @@
 package goverter
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"os/exec"
@@ -11,6 +12,7 @@ import (
 	"testing"
 
 	"github.com/jmattheis/goverter/config"
+	"github.com/jmattheis/goverter/diagnostic"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
@@ -77,6 +79,7 @@ func TestScenario(t *testing.T) {
 					WorkingDir:            testWorkDir,
 					PackagePatterns:       patterns,
 					OutputBuildConstraint: scenario.BuildConstraint,
+					Report:                scenario.Report,
 					BuildTags:             "goverter",
 					Global: config.RawLines{
 						Lines:    scenario.Global,
@@ -90,6 +93,9 @@ func TestScenario(t *testing.T) {
 				if err != nil {
 					scenario.Success = []*OutputFile{}
 					scenario.Error = replaceAbsolutePath(testWorkDir, fmt.Sprint(err))
+					if scenario.Diagnostic != "" {
+						scenario.Diagnostic = diagnosticJSON(testWorkDir, err)
+					}
 				} else {
 					scenario.Success = toOutputFiles(testWorkDir, files)
 					scenario.Error = ""
@@ -103,6 +109,9 @@ func TestScenario(t *testing.T) {
 			if scenario.Error != "" {
 				require.Error(t, err)
 				require.Equal(t, scenario.Error, replaceAbsolutePath(testWorkDir, fmt.Sprint(err)))
+				if scenario.Diagnostic != "" {
+					require.Equal(t, scenario.Diagnostic, diagnosticJSON(testWorkDir, err))
+				}
 				return
 			}
 
@@ -112,6 +121,7 @@ func TestScenario(t *testing.T) {
 
 			err = writeFiles(files)
 			require.NoError(t, err)
+			require.NoError(t, checkFiles(files, true))
 			require.NoError(t, compile(testWorkDir), "generated converter doesn't build")
 		})
 	}
@@ -121,6 +131,14 @@ func replaceAbsolutePath(curPath, body string) string {
 	return filepath.ToSlash(strings.ReplaceAll(body, curPath, "@workdir"))
 }
 
+func diagnosticJSON(curPath string, err error) string {
+	var buf bytes.Buffer
+	if err := diagnostic.WriteJSON(&buf, err); err != nil {
+		panic(err)
+	}
+	return replaceAbsolutePath(curPath, buf.String())
+}
+
 func compile(dir string) error {
 	cmd := exec.Command("go", "build", "./...")
 	cmd.Dir = dir
@@ -155,11 +173,14 @@ type Scenario struct {
 	Global []string          `yaml:"global,omitempty"`
 
 	BuildConstraint string `yaml:"build_constraint,omitempty"`
+	Report          string `yaml:"report,omitempty"`
 
 	Patterns []string      `yaml:"patterns,omitempty"`
 	Success  []*OutputFile `yaml:"success,omitempty"`
 
 	Error string `yaml:"error,omitempty"`
+	// Diagnostic is the JSON diagnostic of Error, it is only checked if set.
+	Diagnostic string `yaml:"diagnostic,omitempty"`
 }
 
 type OutputFile struct {
@@ -0,0 +1,99 @@
+// Package strcase splits identifiers into words and converts their casing.
+package strcase
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Words splits s into words. Words are separated by "_", "-", spaces and
+// casing changes, f.ex. "HTTPServer_id" results in [HTTP Server id].
+func Words(s string) []string {
+	var words []string
+	MapWords(s, func(word string) string {
+		words = append(words, word)
+		return word
+	})
+	return words
+}
+
+// MapWords replaces every word of s with the result of fn. Separators between
+// the words are kept.
+func MapWords(s string, fn func(word string) string) string {
+	runes := []rune(s)
+	var sb strings.Builder
+	start := -1
+	flush := func(end int) {
+		if start != -1 {
+			sb.WriteString(fn(string(runes[start:end])))
+			start = -1
+		}
+	}
+
+	for i, r := range runes {
+		if isSeparator(r) {
+			flush(i)
+			sb.WriteRune(r)
+			continue
+		}
+		if start != -1 && isBoundary(runes, i) {
+			flush(i)
+		}
+		if start == -1 {
+			start = i
+		}
+	}
+	flush(len(runes))
+	return sb.String()
+}
+
+func isSeparator(r rune) bool {
+	return r == '_' || r == '-' || unicode.IsSpace(r)
+}
+
+// isBoundary returns true if a new word starts at index i.
+func isBoundary(runes []rune, i int) bool {
+	prev, cur := runes[i-1], runes[i]
+	if !unicode.IsUpper(cur) {
+		return false
+	}
+	if unicode.IsLower(prev) || unicode.IsDigit(prev) {
+		// userID -> user ID
+		return true
+	}
+	// HTTPServer -> HTTP Server
+	return unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+}
+
+// Snake converts s to snake_case, f.ex. "UserID" results in "user_id".
+func Snake(s string) string {
+	words := Words(s)
+	for i, word := range words {
+		words[i] = strings.ToLower(word)
+	}
+	return strings.Join(words, "_")
+}
+
+// ScreamingSnake converts s to SCREAMING_SNAKE_CASE, f.ex. "UserID" results
+// in "USER_ID".
+func ScreamingSnake(s string) string {
+	return strings.ToUpper(Snake(s))
+}
+
+// Camel converts s to CamelCase, f.ex. "user_id" results in "UserId".
+func Camel(s string) string {
+	var sb strings.Builder
+	for _, word := range Words(s) {
+		sb.WriteString(Title(word))
+	}
+	return sb.String()
+}
+
+// Title upper cases the first letter of s and lower cases the rest.
+func Title(s string) string {
+	runes := []rune(strings.ToLower(s))
+	if len(runes) > 0 {
+		runes[0] = unicode.ToUpper(runes[0])
+	}
+	return string(runes)
+}
@@ -27,7 +27,13 @@ func loadEnum(t *types.Named, cfg *enum.Config) *Enum {
 	path := t.Obj().Pkg().Path()
 	name := t.Obj().Name()
 
-	if !cfg.Enabled || cfg.Excludes.Matches(path, name) {
+	if !cfg.Enabled {
+		return disabled
+	}
+	if e, ok := cfg.Lookup(t); ok {
+		return &Enum{OK: true, Enum: e}
+	}
+	if cfg.Excludes.Matches(path, name) {
 		return disabled
 	}
 
@@ -3,6 +3,7 @@ package xtype
 import (
 	"fmt"
 	"go/types"
+	"reflect"
 	"strings"
 
 	"github.com/dave/jennifer/jen"
@@ -150,14 +151,11 @@ func (err *NoMatchError) Error() string {
 	return fmt.Sprintf("\"%s\" does not exist", err.Field)
 }
 
-func FindField(name string, ignoreCase bool, source *Type, additionalFieldSources []FieldSources) (*StructField, error) {
-	exactMatch, ignoreCaseMatches := source.findAllFields(nil, name, ignoreCase)
-	var exactMatches []*StructField
-	if exactMatch != nil {
-		exactMatches = append(exactMatches, exactMatch)
-	}
-
-	for _, source := range additionalFieldSources {
+// FindField searches the field with the given name in all sources. Exact
+// matches take precedence over case-insensitive matches.
+func FindField(name string, ignoreCase bool, sources []FieldSources) (*StructField, error) {
+	var exactMatches, ignoreCaseMatches []*StructField
+	for _, source := range sources {
 		sourceExactMatch, sourceIgnoreCaseMatches := source.Type.findAllFields(source.Path, name, ignoreCase)
 		if sourceExactMatch != nil {
 			exactMatches = append(exactMatches, sourceExactMatch)
@@ -184,6 +182,89 @@ func FindField(name string, ignoreCase bool, source *Type, additionalFieldSource
 	}
 }
 
+// FindFieldByTag searches the field with the struct tag key:"value" in all
+// sources. nil is returned if there is no field with this tag.
+func FindFieldByTag(key, value, target string, sources []FieldSources) (*StructField, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var matches []*StructField
+	for _, source := range sources {
+		for i := 0; i < source.Type.StructType.NumFields(); i++ {
+			field := source.Type.StructType.Field(i)
+			if TagValue(source.Type.StructType.Tag(i), key) != value {
+				continue
+			}
+			path := append(append([]string{}, source.Path...), field.Name())
+			matches = append(matches, &StructField{Path: path, Type: TypeOf(field.Type()).inStruct(source.Type, field.Name())})
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return matches[0], nil
+	default:
+		names := make([]string, 0, len(matches))
+		for _, m := range matches {
+			names = append(names, strings.Join(m.Path, "."))
+		}
+		return nil, ambiguousTagMatchError(fmt.Sprintf("%s:%q", key, value), target, names)
+	}
+}
+
+// FindFieldTransformed searches the field whose name equals name after both
+// were transformed with transform. nil is returned if there is no such field.
+func FindFieldTransformed(name string, transform func(string) string, sources []FieldSources) (*StructField, error) {
+	want := transform(name)
+
+	var matches []*StructField
+	for _, source := range sources {
+		t := source.Type
+		handle := func(obj types.Object) {
+			if transform(obj.Name()) != want {
+				return
+			}
+			path := append(append([]string{}, source.Path...), obj.Name())
+			matches = append(matches, &StructField{Path: path, Type: TypeOf(obj.Type()).inStruct(t, obj.Name())})
+		}
+
+		for i := 0; i < t.StructType.NumFields(); i++ {
+			handle(t.StructType.Field(i))
+		}
+		if t.Named {
+			for i := 0; i < t.NamedType.NumMethods(); i++ {
+				handle(t.NamedType.Method(i))
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return matches[0], nil
+	default:
+		names := make([]string, 0, len(matches))
+		for _, m := range matches {
+			names = append(names, strings.Join(m.Path, "."))
+		}
+		return nil, ambiguousMatchError(name, names)
+	}
+}
+
+// TagValue returns the value of the struct tag key without options. Empty
+// and ignored ("-") values are returned as empty string.
+func TagValue(tag, key string) string {
+	value, _, _ := strings.Cut(reflect.StructTag(tag).Get(key), ",")
+	if value == "-" {
+		return ""
+	}
+	return value
+}
+
 // JenID a jennifer code wrapper with extra infos.
 type JenID struct {
 	ParentPointer *JenID
@@ -331,6 +412,16 @@ func (t Type) TypeAsJen() *jen.Statement {
 	return toCode(t.T)
 }
 
+func ambiguousTagMatchError(tag, target string, ambNames []string) error {
+	return fmt.Errorf(`multiple fields with tag %s found. Possible matches: %s.
+
+Explicitly define the mapping via goverter:map. Example:
+
+    goverter:map %s %s
+
+See https://goverter.jmattheis.de/reference/map`, tag, strings.Join(ambNames, ", "), ambNames[0], target)
+}
+
 func ambiguousMatchError(name string, ambNames []string) error {
 	return fmt.Errorf(`multiple matches found for %q. Possible matches: %s.
 